@@ -0,0 +1,116 @@
+package validation
+
+import (
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// FromValues converts query string or form values into input for Schema.Validate, so the rules written for JSON
+// bodies apply to GET filters and form posts as well.
+//
+// A key sent once becomes a string and a key sent several times a []any of strings, so scalar rules see a scalar
+// and Each or MaxSize see the repeated values. A key ending in "[]", as in "tags[]=a", is always a []any and is stored
+// without the brackets; when both "tag" and "tag[]" are sent, the values of "tag" come first. A key sent without a
+// value, as in "?draft", is an empty string.
+//
+// Example:
+//
+//	schema := validation.New().
+//		Field("page", validation.Numeric).
+//		Field("tag", validation.Each(validation.Slug))
+//
+//	res, err := schema.Validate(validation.FromValues(r.URL.Query()))
+//	// ?page=2&tag=go&tag=json → {"page": "2", "tag": ["go", "json"]}
+func FromValues(values url.Values) map[string]any {
+	input := make(map[string]any, len(values))
+	// In sorted order "tag" comes before "tag[]", which appends to it.
+	for _, key := range sortedKeys(values) {
+		vs := values[key]
+		if name, ok := strings.CutSuffix(key, "[]"); ok {
+			input[name] = appendStrings(input[name], vs)
+			continue
+		}
+		input[key] = singleOrList(vs)
+	}
+
+	return input
+}
+
+// FromHeader converts request headers into input for Schema.Validate, with the same single and multi-value handling
+// as FromValues. Keys are in canonical form (see http.CanonicalHeaderKey), so paths name headers as "X-Request-Id".
+//
+// Example:
+//
+//	schema := validation.New().Field("X-Request-Id", validation.Required, validation.UUID)
+//	res, err := schema.Validate(validation.FromHeader(r.Header))
+func FromHeader(header http.Header) map[string]any {
+	input := make(map[string]any, len(header))
+	for key, vs := range header {
+		input[http.CanonicalHeaderKey(key)] = singleOrList(vs)
+	}
+
+	return input
+}
+
+// FromMultipart converts a parsed multipart form into input for Schema.Validate. Values are handled as in
+// FromValues. Files are *multipart.FileHeader values, a []any of them when several are sent under one key, and
+// replace a value of the same name; validate them with MaxFileSize and FileType.
+//
+// Example:
+//
+//	if err := r.ParseMultipartForm(32 << 20); err != nil {
+//		...
+//	}
+//
+//	schema := validation.New().
+//		Field("title", validation.Required).
+//		Field("avatar", validation.Required, validation.MaxFileSize(2<<20), validation.FileType("image/*"))
+//
+//	res, err := schema.Validate(validation.FromMultipart(r.MultipartForm))
+func FromMultipart(form *multipart.Form) map[string]any {
+	if form == nil {
+		return map[string]any{}
+	}
+
+	input := FromValues(form.Value)
+	for key, files := range form.File {
+		name, forceList := strings.CutSuffix(key, "[]")
+		list := make([]any, len(files))
+		for i, f := range files {
+			list[i] = f
+		}
+		if len(list) == 1 && !forceList {
+			input[name] = list[0]
+		} else {
+			input[name] = list
+		}
+	}
+
+	return input
+}
+
+func singleOrList(vs []string) any {
+	if len(vs) == 1 {
+		return vs[0]
+	}
+
+	return appendStrings(nil, vs)
+}
+
+// appendStrings appends vs to the list or single value in current.
+func appendStrings(current any, vs []string) []any {
+	var list []any
+	switch c := current.(type) {
+	case []any:
+		list = c
+	case string:
+		list = []any{c}
+	}
+	for _, v := range vs {
+		list = append(list, v)
+	}
+
+	return list
+}
@@ -0,0 +1,130 @@
+package validation
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestFromValues(t *testing.T) {
+	values, err := url.ParseQuery("page=2&tag=go&tag=json&ids[]=7&draft&sort[]=a&sort=b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]any{
+		"page":  "2",
+		"tag":   []any{"go", "json"},
+		"ids":   []any{"7"},
+		"draft": "",
+		"sort":  []any{"b", "a"}, // "sort" before "sort[]"
+	}
+	got := FromValues(values)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromValues() = %v, want %v", got, want)
+	}
+
+	schema := New().
+		Field("page", Required, Numeric).
+		Field("tag", Each(Slug)).
+		Field("ids", MaxSize(1))
+	res, err := schema.Validate(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+}
+
+func TestFromHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("x-request-id", "0b3b7a9e-5c1e-4c36-9a4a-3f7d6c2e8b11")
+	header.Add("Accept", "text/html")
+	header.Add("Accept", "application/json")
+
+	got := FromHeader(header)
+	want := map[string]any{
+		"X-Request-Id": "0b3b7a9e-5c1e-4c36-9a4a-3f7d6c2e8b11",
+		"Accept":       []any{"text/html", "application/json"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromHeader() = %v, want %v", got, want)
+	}
+
+	res, err := New().Field("X-Request-Id", Required, UUID).Validate(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+}
+
+func TestFromMultipart(t *testing.T) {
+	form := testMultipartForm(
+		t, map[string]string{"title": "Hello"}, map[string][]string{
+			"avatar":   {pngHeader},
+			"photos[]": {pngHeader},
+			"docs":     {"%PDF-1.4 a", "%PDF-1.4 b"},
+		},
+	)
+
+	input := FromMultipart(form)
+	if input["title"] != "Hello" {
+		t.Errorf("title = %v", input["title"])
+	}
+	if _, ok := input["avatar"].(*multipart.FileHeader); !ok {
+		t.Errorf("avatar = %T, want *multipart.FileHeader", input["avatar"])
+	}
+	if photos, ok := input["photos"].([]any); !ok || len(photos) != 1 {
+		t.Errorf("photos = %v, want a list of one file", input["photos"])
+	}
+	if docs, ok := input["docs"].([]any); !ok || len(docs) != 2 {
+		t.Errorf("docs = %v, want a list of two files", input["docs"])
+	}
+
+	if got := FromMultipart(nil); len(got) != 0 {
+		t.Errorf("FromMultipart(nil) = %v, want empty input", got)
+	}
+}
+
+const pngHeader = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+
+// testMultipartForm builds a parsed multipart form with the given values and files, keyed by field name.
+func testMultipartForm(t *testing.T, values map[string]string, files map[string][]string) *multipart.Form {
+	t.Helper()
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for k, v := range values {
+		if err := w.WriteField(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for k, contents := range files {
+		for _, content := range contents {
+			part, err := w.CreateFormFile(k, k+".bin")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := part.Write([]byte(content)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	form, err := multipart.NewReader(&body, w.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = form.RemoveAll() })
+
+	return form
+}
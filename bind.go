@@ -0,0 +1,422 @@
+package validation
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Bind validates input with the schema and, when it passes, populates a T from it, so a request is decoded once and
+// checked and bound under the same field names.
+//
+// Bind validates the way Process does, so a T receives the sanitized values. Struct fields are matched to keys the
+// way paths resolve them: by the name in the json tag, then by the Go field name, with the fields of embedded structs
+// promoted. Keys without a matching field are ignored. As with encoding/json, fields implementing json.Unmarshaler,
+// such as json.RawMessage and time.Time, receive the value re-encoded as JSON, strings fill other fields implementing
+// encoding.TextUnmarshaler, and base64 strings fill []byte fields. Numbers convert to any numeric field that holds
+// them exactly, and nil leaves a field at its zero value.
+//
+// When validation fails, Bind returns the zero T and the result. A value that does not fit its field, such as a
+// string for an int field the schema does not check, is reported in the result as well, with the code "type" and the
+// expected JSON type ("string", "integer", "number", "boolean", "object", or "array") in Params["type"]. Errors are
+// reported in path order.
+//
+// Example:
+//
+//	type signup struct {
+//		Email string `json:"email"`
+//		Age   int    `json:"age"`
+//	}
+//
+//	req, res, err := validation.Bind[signup](schema, input)
+func Bind[T any](schema *Schema, input map[string]any) (T, *Result, error) {
+	var out T
+
+	cleaned, res, err := schema.Process(input)
+	if err != nil || res.HasErrors() {
+		return out, res, err
+	}
+
+	var errs []FieldError
+	bindValue(reflect.ValueOf(&out).Elem(), cleaned, "", &errs)
+	if len(errs) > 0 {
+		var zero T
+		return zero, &Result{errors: errs}, nil
+	}
+
+	return out, res, nil
+}
+
+// BindJSON decodes a JSON object from r and binds it with Bind. A body that is not valid JSON or not an object is
+// returned as the error.
+//
+// Numbers are decoded as float64, as by encoding/json, except integers beyond ±2^53, which float64 cannot hold
+// exactly: they stay json.Number, so the schema's rules see them as loose mode and asString-based rules do, and they
+// bind to integer fields without losing precision.
+//
+// Example:
+//
+//	req, res, err := validation.BindJSON[signup](schema, r.Body)
+func BindJSON[T any](schema *Schema, r io.Reader) (T, *Result, error) {
+	var input map[string]any
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if err := dec.Decode(&input); err != nil {
+		var zero T
+		return zero, nil, err
+	}
+	floatNumbers(input)
+
+	return Bind[T](schema, input)
+}
+
+// maxExactInt is the largest integer up to which every integer has an exact float64 representation.
+const maxExactInt = 1 << 53
+
+// floatNumbers replaces the json.Number values of a decoded JSON value by float64 in place, keeping those that hold
+// an integer beyond ±2^53 or do not fit a float64.
+func floatNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = floatNumbers(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = floatNumbers(e)
+		}
+	case json.Number:
+		if inexactInt(string(v)) {
+			return v
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	}
+
+	return value
+}
+
+// inexactInt reports whether s is an integer beyond ±2^53.
+func inexactInt(s string) bool {
+	n, err := strconv.ParseUint(strings.TrimPrefix(s, "-"), 10, 64)
+
+	return (err == nil && n > maxExactInt) || errors.Is(err, strconv.ErrRange)
+}
+
+// bindValue stores src in dst, appending a type error at path when it does not fit.
+func bindValue(dst reflect.Value, src any, path string, errs *[]FieldError) {
+	if src == nil {
+		return
+	}
+
+	if dst.CanAddr() {
+		if u, ok := dst.Addr().Interface().(json.Unmarshaler); ok {
+			data, err := json.Marshal(src)
+			if err != nil || u.UnmarshalJSON(data) != nil {
+				*errs = append(*errs, bindTypeError(path, unmarshalType(dst, src)))
+			}
+			return
+		}
+	}
+
+	if s, ok := src.(string); ok && dst.CanAddr() {
+		if u, ok := dst.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			if u.UnmarshalText([]byte(s)) != nil {
+				*errs = append(*errs, bindTypeError(path, "string"))
+			}
+			return
+		}
+	}
+
+	if sv := reflect.ValueOf(src); sv.Type().AssignableTo(dst.Type()) {
+		dst.Set(sv)
+		return
+	}
+
+	if !bindKind(dst, src, path, errs) {
+		*errs = append(*errs, bindTypeError(path, jsonTypeOf(dst.Type())))
+	}
+}
+
+// bindKind converts src to the kind of dst, reporting false when it cannot.
+func bindKind(dst reflect.Value, src any, path string, errs *[]FieldError) bool {
+	switch dst.Kind() {
+	case reflect.Pointer:
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		bindValue(dst.Elem(), src, path, errs)
+		return true
+	case reflect.Struct:
+		return bindStruct(dst, src, path, errs)
+	case reflect.Map:
+		return bindMap(dst, src, path, errs)
+	case reflect.Slice, reflect.Array:
+		if s, ok := src.(string); ok && dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8 {
+			return bindBytes(dst, s)
+		}
+		return bindList(dst, src, path, errs)
+	case reflect.String:
+		s, ok := src.(string)
+		if ok {
+			dst.SetString(s)
+		}
+		return ok
+	case reflect.Bool:
+		b, ok := src.(bool)
+		if ok {
+			dst.SetBool(b)
+		}
+		return ok
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return bindNumber(dst, src)
+	default:
+		return false
+	}
+}
+
+func bindStruct(dst reflect.Value, src any, path string, errs *[]FieldError) bool {
+	m, ok := src.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	for _, key := range sortedKeys(m) {
+		if f, ok := settableField(dst, key); ok {
+			bindValue(f, m[key], joinPath(path, key), errs)
+		}
+	}
+
+	return true
+}
+
+func bindMap(dst reflect.Value, src any, path string, errs *[]FieldError) bool {
+	m, ok := src.(map[string]any)
+	if !ok || dst.Type().Key().Kind() != reflect.String {
+		return false
+	}
+
+	out := reflect.MakeMapWithSize(dst.Type(), len(m))
+	for _, key := range sortedKeys(m) {
+		elem := reflect.New(dst.Type().Elem()).Elem()
+		bindValue(elem, m[key], joinPath(path, key), errs)
+		out.SetMapIndex(reflect.ValueOf(key).Convert(dst.Type().Key()), elem)
+	}
+	dst.Set(out)
+
+	return true
+}
+
+func bindList(dst reflect.Value, src any, path string, errs *[]FieldError) bool {
+	l, ok := src.([]any)
+	if !ok {
+		return false
+	}
+
+	if dst.Kind() == reflect.Slice {
+		dst.Set(reflect.MakeSlice(dst.Type(), len(l), len(l)))
+	} else if len(l) > dst.Len() {
+		return false
+	}
+	for i, v := range l {
+		bindValue(dst.Index(i), v, joinPath(path, strconv.Itoa(i)), errs)
+	}
+
+	return true
+}
+
+// bindBytes stores a base64 string in a byte slice, as encoding/json does.
+func bindBytes(dst reflect.Value, s string) bool {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return false
+	}
+	dst.SetBytes(b)
+
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// bindNumber stores a numeric src in a numeric dst when the value fits exactly.
+func bindNumber(dst reflect.Value, src any) bool {
+	if n, ok := src.(json.Number); ok {
+		return bindJSONNumber(dst, n)
+	}
+
+	sv := reflect.ValueOf(src)
+	switch dst.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		switch {
+		case sv.CanInt():
+			n = sv.Int()
+		case sv.CanUint() && sv.Uint() <= 1<<63-1:
+			n = int64(sv.Uint())
+		case sv.CanFloat() && sv.Float() == float64(int64(sv.Float())):
+			n = int64(sv.Float())
+		default:
+			return false
+		}
+		if dst.OverflowInt(n) {
+			return false
+		}
+		dst.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, ok := numberValue(src)
+		if !ok || dst.OverflowFloat(f) {
+			return false
+		}
+		dst.SetFloat(f)
+	default:
+		var n uint64
+		switch {
+		case sv.CanUint():
+			n = sv.Uint()
+		case sv.CanInt() && sv.Int() >= 0:
+			n = uint64(sv.Int())
+		case sv.CanFloat() && sv.Float() >= 0 && sv.Float() == float64(uint64(sv.Float())):
+			n = uint64(sv.Float())
+		default:
+			return false
+		}
+		if dst.OverflowUint(n) {
+			return false
+		}
+		dst.SetUint(n)
+	}
+
+	return true
+}
+
+// bindJSONNumber stores n in a numeric dst, parsing it for the kind of dst so large integers keep their precision.
+func bindJSONNumber(dst reflect.Value, n json.Number) bool {
+	switch dst.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(string(n), 10, 64)
+		if err != nil || dst.OverflowInt(i) {
+			return false
+		}
+		dst.SetInt(i)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(string(n), 64)
+		if err != nil || dst.OverflowFloat(f) {
+			return false
+		}
+		dst.SetFloat(f)
+	default:
+		u, err := strconv.ParseUint(string(n), 10, 64)
+		if err != nil || dst.OverflowUint(u) {
+			return false
+		}
+		dst.SetUint(u)
+	}
+
+	return true
+}
+
+// settableField returns the field of a struct that a path segment resolves to, in the order structField uses,
+// allocating nil embedded pointers on the way.
+func settableField(rv reflect.Value, name string) (reflect.Value, bool) {
+	index, ok := fieldIndex(rv.Type(), name)
+	if !ok {
+		return reflect.Value{}, false
+	}
+
+	for i, n := range index {
+		if i > 0 && rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				if !rv.CanSet() {
+					return reflect.Value{}, false
+				}
+				rv.Set(reflect.New(rv.Type().Elem()))
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(n)
+	}
+
+	return rv, rv.CanSet()
+}
+
+func fieldIndex(rt reflect.Type, name string) ([]int, bool) {
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.Anonymous {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if index, ok := fieldIndex(ft, name); ok {
+					return append([]int{i}, index...), true
+				}
+				continue
+			}
+		}
+		if field.IsExported() && fieldMatches(field, name) {
+			return []int{i}, true
+		}
+	}
+
+	return nil, false
+}
+
+// unmarshalType names the JSON type expected by a json.Unmarshaler that rejected src: a string for strings, as for
+// time.Time, and otherwise the type dst is decoded from.
+func unmarshalType(dst reflect.Value, src any) string {
+	if _, ok := src.(string); ok {
+		return "string"
+	}
+
+	return jsonTypeOf(dst.Type())
+}
+
+// jsonTypeOf names the JSON type a Go type is decoded from.
+func jsonTypeOf(rt reflect.Type) string {
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt.Kind() == reflect.Slice && rt.Elem().Kind() == reflect.Uint8 {
+		return "string" // base64
+	}
+
+	switch rt.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Struct, reflect.Map:
+		return "object"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "string"
+	}
+}
+
+func bindTypeError(path, typ string) FieldError {
+	err := typeError{Type: typ}
+
+	return FieldError{Path: path, Err: err, Message: err.Error(), Code: err.Code(), Params: err.Params()}
+}
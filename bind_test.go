@@ -0,0 +1,258 @@
+package validation
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type bindBase struct {
+	ID int `json:"id"`
+}
+
+type bindAddress struct {
+	City string `json:"city"`
+}
+
+type bindUser struct {
+	bindBase
+	Email    string            `json:"email"`
+	Age      uint8             `json:"age"`
+	Score    float32           `json:"score"`
+	Admin    bool              `json:"admin"`
+	Nick     *string           `json:"nick"`
+	Born     time.Time         `json:"born"`
+	Tags     []string          `json:"tags"`
+	Address  *bindAddress      `json:"address"`
+	Labels   map[string]int    `json:"labels"`
+	Extra    any               `json:"extra"`
+	Secret   string            `json:"-"`
+	Country  string            // matched by Go field name
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+func TestBind(t *testing.T) {
+	schema := New().
+		Field("email", Required, Email).Sanitize(Trim, ToLower).
+		Field("age", Required)
+
+	input := map[string]any{
+		"id":      float64(7),
+		"email":   " Ann@Example.com ",
+		"age":     float64(30),
+		"score":   0.5,
+		"admin":   true,
+		"nick":    "annie",
+		"born":    "1990-01-02T00:00:00Z",
+		"tags":    []any{"a", "b"},
+		"address": map[string]any{"city": "Paris"},
+		"labels":  map[string]any{"x": float64(1)},
+		"extra":   []any{"kept"},
+		"Secret":  "ignored",
+		"-":       "ignored",
+		"Country": "FR",
+		"unknown": "ignored",
+	}
+
+	got, res, err := Bind[bindUser](schema, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+
+	nick := "annie"
+	want := bindUser{
+		bindBase: bindBase{ID: 7},
+		Email:    "ann@example.com",
+		Age:      30,
+		Score:    0.5,
+		Admin:    true,
+		Nick:     &nick,
+		Born:     time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC),
+		Tags:     []string{"a", "b"},
+		Address:  &bindAddress{City: "Paris"},
+		Labels:   map[string]int{"x": 1},
+		Extra:    []any{"kept"},
+		Country:  "FR",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Bind() = %+v\nwant %+v", got, want)
+	}
+}
+
+func TestBind_Errors(t *testing.T) {
+	schema := New().Field("email", Required, Email)
+
+	tests := []struct {
+		name  string
+		input map[string]any
+		want  []string
+	}{
+		{"validation", map[string]any{"email": "nope", "age": "x"}, []string{"email:email"}},
+		{"string for int", map[string]any{"email": "a@b.co", "age": "x"}, []string{"age:type:integer"}},
+		{"fraction for int", map[string]any{"email": "a@b.co", "age": 1.5}, []string{"age:type:integer"}},
+		{"overflow", map[string]any{"email": "a@b.co", "age": float64(300)}, []string{"age:type:integer"}},
+		{"negative for uint", map[string]any{"email": "a@b.co", "age": float64(-1)}, []string{"age:type:integer"}},
+		{"bad time", map[string]any{"email": "a@b.co", "born": "yesterday"}, []string{"born:type:string"}},
+		{
+			"nested paths",
+			map[string]any{"email": "a@b.co", "tags": []any{"a", 1.0}, "address": map[string]any{"city": true}},
+			[]string{"address.city:type:string", "tags.1:type:string"},
+		},
+		{"object for string", map[string]any{"email": map[string]any{}}, []string{"email:email"}},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, res, err := Bind[bindUser](schema, tt.input)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, bindUser{}) {
+					t.Errorf("Bind() = %+v, want the zero value", got)
+				}
+
+				var codes []string
+				for _, fe := range res.Errors() {
+					c := fe.Path + ":" + fe.Code
+					if typ, ok := fe.Params["type"]; ok {
+						c += ":" + typ.(string)
+					}
+					codes = append(codes, c)
+				}
+				slices.Sort(codes)
+				if !slices.Equal(codes, tt.want) {
+					t.Errorf("errors = %v, want %v", codes, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestBindJSON(t *testing.T) {
+	schema := New().Field("email", Required, Email)
+
+	got, res, err := BindJSON[*bindUser](schema, strings.NewReader(`{"email": "a@b.co", "age": 41, "tags": ["x"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+	if got == nil || got.Email != "a@b.co" || got.Age != 41 || !slices.Equal(got.Tags, []string{"x"}) {
+		t.Errorf("BindJSON() = %+v", got)
+	}
+
+	for _, body := range []string{`{"email": `, `["a@b.co"]`} {
+		if _, _, err := BindJSON[bindUser](schema, strings.NewReader(body)); err == nil {
+			t.Errorf("BindJSON(%s): expected error", body)
+		}
+	}
+}
+
+func TestBindJSON_LargeIntegers(t *testing.T) {
+	type record struct {
+		ID    int64   `json:"id"`
+		Big   uint64  `json:"big"`
+		Small int8    `json:"small"`
+		Ratio float64 `json:"ratio"`
+		Any   any     `json:"any"`
+	}
+
+	schema := New().
+		Field("id", Required, Loose(Between[int64](1, math.MaxInt64))).
+		Field("ratio", Between[float64](0, 1))
+	body := `{"id": 9007199254740993, "big": 18446744073709551615, "small": 5, "ratio": 0.5, "any": 2}`
+
+	got, res, err := BindJSON[record](schema, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors: %v", pathCodes(res))
+	}
+	want := record{ID: 9007199254740993, Big: math.MaxUint64, Small: 5, Ratio: 0.5, Any: 2.0}
+	if got != want {
+		t.Errorf("BindJSON() = %+v, want %+v", got, want)
+	}
+
+	_, res, err = BindJSON[record](New(), strings.NewReader(`{"small": 9007199254740993, "id": 1e400}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := pathCodes(res), []string{"id:type", "small:type"}; !slices.Equal(got, want) {
+		t.Errorf("errors = %v, want %v", got, want)
+	}
+}
+
+type bindLevel int
+
+func (l *bindLevel) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	switch name {
+	case "low":
+		*l = 1
+	case "high":
+		*l = 2
+	default:
+		return errors.New("unknown level")
+	}
+	return nil
+}
+
+func TestBind_EncodingJSONTypes(t *testing.T) {
+	type payload struct {
+		Data  []byte          `json:"data"`
+		Raw   json.RawMessage `json:"raw"`
+		Level bindLevel       `json:"level"`
+		Ptr   *bindLevel      `json:"ptr"`
+	}
+
+	input := map[string]any{
+		"data":  base64.StdEncoding.EncodeToString([]byte("hello")),
+		"raw":   map[string]any{"a": []any{1.0, "x"}},
+		"level": "high",
+		"ptr":   "low",
+	}
+	got, res, err := Bind[payload](New(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Fatalf("unexpected errors %v", pathCodes(res))
+	}
+	if string(got.Data) != "hello" || string(got.Raw) != `{"a":[1,"x"]}` || got.Level != 2 || *got.Ptr != 1 {
+		t.Errorf("Bind() = %+v", got)
+	}
+
+	var want payload
+	body := `{"data":"aGVsbG8=","raw":{"a":[1,"x"]},"level":"high","ptr":"low"}`
+	if err := json.Unmarshal([]byte(body), &want); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Bind() = %+v, encoding/json = %+v", got, want)
+	}
+
+	bad := map[string]any{"raw": nil, "level": "medium", "data": "not base64!", "ptr": 3.0}
+	for i := 0; i < 5; i++ {
+		_, res, err := Bind[payload](New(), bad)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := pathCodes(res), []string{"data:type", "level:type", "ptr:type"}; !slices.Equal(got, want) {
+			t.Fatalf("errors = %v, want %v", got, want)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package validation
 
-import "reflect"
+import (
+	"reflect"
+	"strconv"
+)
 
 // Distinct is a Rule that validates the value is a slice or array with no duplicate elements.
 //
@@ -44,8 +47,13 @@ var Distinct Rule = RuleFunc(
 
 // Each returns a Rule that applies the given rules to every element of a slice or array.
 //
-// Non-slice/array values and nil pass (the rule is irrelevant for scalars). Validation stops at the first failing
-// element and returns basicError{"each", "each validation failed"}; the index and inner error are not propagated.
+// Non-slice/array values and nil pass (the rule is irrelevant for scalars). Every element is checked against every
+// rule, and each failure is reported as its own FieldError at the element's index with the inner rule's Code and
+// Params, e.g. "tags.2" for the third element of "tags". Failures produced inside an element, e.g. by Nested or a
+// nested Each, keep their relative path below the index ("items.1.sku"). Called directly, the rule returns an error
+// with code "each".
+//
+// A RuleSyntaxError or context error from an inner rule is returned unchanged.
 //
 // Fails if:
 //   - any element fails any of the given rules
@@ -56,26 +64,46 @@ var Distinct Rule = RuleFunc(
 //	validation.Each(validation.MinLength(2)).Validate([]string{"ab", "x"})  // fail — "x" fails
 //	validation.Each(validation.Positive).Validate([]int{1, 2, 3})           // pass
 //	validation.Each(validation.Positive).Validate([]int{1, -1, 3})          // fail
+//
+//	schema := validation.New().Field("tags", validation.Each(validation.Slug))
+//	// {"tags": []string{"ok", "Not OK"}} → error at "tags.1" with code "slug"
 func Each(rules ...Rule) Rule {
-	return InputRuleFunc(
+	describeItems := func(b *jsonSchemaBuilder, n *jsonSchemaNode) {
+		b.addRules(n.itemsNode(), rules)
+	}
+
+	rule := InputRuleFunc(
 		func(value any, input *InputBag) error {
 			rv := reflect.ValueOf(value)
 			if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
 				return nil
 			}
 
+			var errs []FieldError
 			for i := 0; i < rv.Len(); i++ {
 				elem := rv.Index(i).Interface()
 				for _, r := range rules {
-					if err := applyRule(r, elem, input); err != nil {
-						return basicError{"each", "each validation failed"}
+					err := applyRule(r, elem, input)
+					if err == nil {
+						continue
+					}
+
+					if abortsValidation(err) {
+						return err
 					}
+					errs = append(errs, fieldErrors(strconv.Itoa(i), err)...)
 				}
 			}
 
+			if len(errs) > 0 {
+				return nestedError{code: "each", message: "each validation failed", errors: errs}
+			}
+
 			return nil
 		},
 	)
+
+	return describedRule{rule: rule, describe: describeItems, items: rules}
 }
 
 // MaxSize returns a Rule that validates a slice or array has at most n elements.
@@ -91,7 +119,7 @@ func Each(rules ...Rule) Rule {
 //	validation.MaxSize(3).Validate([]int{1, 2, 3, 4}) // fail — 4 elements
 //	validation.MaxSize(3).Validate(nil)                // pass
 func MaxSize(n int) Rule {
-	return RuleFunc(
+	return withKeywords("", map[string]any{"maxItems": n}, RuleFunc(
 		func(value any) error {
 			rv := reflect.ValueOf(value)
 			if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
@@ -104,7 +132,7 @@ func MaxSize(n int) Rule {
 
 			return nil
 		},
-	)
+	))
 }
 
 // MinSize returns a Rule that validates a slice or array has at least n elements.
@@ -119,7 +147,7 @@ func MaxSize(n int) Rule {
 //	validation.MinSize(2).Validate([]int{1, 2})    // pass — exactly 2
 //	validation.MinSize(2).Validate([]int{1})        // fail — only 1 element
 func MinSize(n int) Rule {
-	return RuleFunc(
+	return withKeywords("", map[string]any{"minItems": n}, RuleFunc(
 		func(value any) error {
 			rv := reflect.ValueOf(value)
 			if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
@@ -132,7 +160,7 @@ func MinSize(n int) Rule {
 
 			return nil
 		},
-	)
+	))
 }
 
 // Size returns a Rule that validates a slice or array has exactly n elements.
@@ -147,7 +175,7 @@ func MinSize(n int) Rule {
 //	validation.Size(3).Validate([]int{1, 2, 3}) // pass
 //	validation.Size(3).Validate([]int{1, 2})    // fail — 2 elements
 func Size(n int) Rule {
-	return RuleFunc(
+	return withKeywords("", map[string]any{"minItems": n, "maxItems": n}, RuleFunc(
 		func(value any) error {
 			rv := reflect.ValueOf(value)
 			if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
@@ -160,5 +188,5 @@ func Size(n int) Rule {
 
 			return nil
 		},
-	)
+	))
 }
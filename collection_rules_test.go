@@ -1,6 +1,8 @@
 package validation
 
 import (
+	"errors"
+	"slices"
 	"testing"
 )
 
@@ -179,3 +181,55 @@ func TestEach_WithInputRule(t *testing.T) {
 		},
 	)
 }
+
+func TestEach_PerElementErrors(t *testing.T) {
+	schema := New().
+		Field("tags", Each(MinLength(2), Lowercase)).
+		Field("items", Each(Nested(New().Field("sku", Required))))
+
+	res, err := schema.Validate(
+		map[string]any{
+			"tags":  []string{"ok", "x", "fine", "Y"},
+			"items": []any{map[string]any{"sku": "a"}, map[string]any{}},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := pathCodes(res)
+	want := []string{
+		"tags.1:min_length",
+		"tags.3:min_length",
+		"tags.3:lowercase",
+		"items.1.sku:required",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("errors = %v, want %v", got, want)
+	}
+
+	if p := res.For("tags.1")[0].Params; p["length"] != 2 {
+		t.Errorf("expected inner params to be propagated, got %v", p)
+	}
+}
+
+func TestEach_NestedEach(t *testing.T) {
+	schema := New().Field("matrix", Each(Each(Positive)))
+
+	res, err := schema.Validate(map[string]any{"matrix": [][]int{{1, 2}, {3, -4}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.For("matrix.1.1")) != 1 {
+		t.Errorf("expected error at matrix.1.1, got %v", res.Errors())
+	}
+}
+
+func TestEach_RuleSyntaxError(t *testing.T) {
+	_, err := New().Field("tags", Each(Regex("["))).Validate(map[string]any{"tags": []string{"a"}})
+
+	var rse RuleSyntaxError
+	if !errors.As(err, &rse) {
+		t.Errorf("expected RuleSyntaxError, got %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package validation
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Concurrent lets Validate and ValidateContext check up to n fields at once, which pays off for schemas with slow rules
+// such as remote lookups or large Each collections. Each concrete path of a wildcard field counts as a separate field.
+// n <= 1 restores sequential validation, the default.
+//
+// The Result is identical to a sequential run: errors keep field order, FailFast still reports the first error in
+// that order, and a RuleSyntaxError or context error is returned as soon as every field before it has finished. Fields
+// after the one that ends validation are not started, though some may already be running.
+//
+// Rules of different fields run on different goroutines, so custom rules must be safe for concurrent use, as rules
+// shared between schemas and Validate calls already need to be.
+//
+// Concurrent returns the receiver to support chaining.
+//
+// Example:
+//
+//	schema := validation.New().
+//		Field("email", validation.Required, emailMX).
+//		Field("username", validation.Required, uniqueUsername).
+//		Concurrent(4)
+func (s *Schema) Concurrent(n int) *Schema {
+	s.concurrency = n
+
+	return s
+}
+
+// fieldJobResult is the outcome of one fieldJob.
+type fieldJobResult struct {
+	errs []FieldError
+	err  error
+}
+
+// validateConcurrently runs jobs on a bounded pool of workers and merges the results in job order. Jobs are handed out
+// in order, and stop holds the lowest index of a job that ends validation, so once a worker draws an index past stop
+// every remaining job is skipped while every job before stop still completes.
+func (s *Schema) validateConcurrently(ctx context.Context, inputBag *InputBag, jobs []fieldJob) (*Result, error) {
+	results := make([]fieldJobResult, len(jobs))
+
+	var next, stop atomic.Int64
+	stop.Store(int64(len(jobs)))
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(s.concurrency, len(jobs)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := next.Add(1) - 1
+				if i >= int64(len(jobs)) || i > stop.Load() {
+					return
+				}
+
+				res := &results[i]
+				if err := ctx.Err(); err != nil {
+					res.err = err
+				} else {
+					res.errs, res.err = s.validateJob(jobs[i], inputBag)
+				}
+
+				if res.err != nil || (s.failFast && len(res.errs) > 0) {
+					lowerStop(&stop, i)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	var errs []FieldError
+	for _, res := range results {
+		if res.err != nil {
+			return nil, res.err
+		}
+		errs = append(errs, res.errs...)
+
+		if s.failFast && len(errs) > 0 {
+			return &Result{errors: errs[:1]}, nil
+		}
+	}
+
+	return &Result{errors: errs}, nil
+}
+
+// lowerStop sets stop to i unless it already holds a lower index.
+func lowerStop(stop *atomic.Int64, i int64) {
+	for {
+		cur := stop.Load()
+		if i >= cur || stop.CompareAndSwap(cur, i) {
+			return
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// slowRule sleeps for a duration derived from the value so concurrent fields finish out of order.
+func slowRule(running, peak *atomic.Int64) Rule {
+	return RuleFunc(
+		func(value any) error {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+
+			s, _ := value.(string)
+			time.Sleep(time.Duration(5-len(s)%5) * time.Millisecond)
+			if len(s) < 3 {
+				return basicError{"slow", "slow validation failed"}
+			}
+			return nil
+		},
+	)
+}
+
+func concurrencyTestSchema(running, peak *atomic.Int64) *Schema {
+	address := New().Field("city", Required, slowRule(running, peak))
+
+	return New().
+		Field("name", Required, slowRule(running, peak)).
+		Field("tags.*", slowRule(running, peak), MinLength(2)).
+		Field("address", Nested(address)).
+		Field("email", Required, Email)
+}
+
+var concurrencyTestInput = map[string]any{
+	"name":    "Al",
+	"tags":    []any{"a", "bcd", "e", "fghij", "k"},
+	"address": map[string]any{"city": "X"},
+	"email":   "nope",
+}
+
+func TestSchemaConcurrent(t *testing.T) {
+	var running, peak atomic.Int64
+
+	sequential, err := concurrencyTestSchema(&running, &peak).Validate(concurrencyTestInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peak.Load() != 1 {
+		t.Fatalf("sequential peak = %d, want 1", peak.Load())
+	}
+
+	for _, n := range []int{2, 4, 16} {
+		t.Run(
+			strconv.Itoa(n), func(t *testing.T) {
+				peak.Store(0)
+				schema := concurrencyTestSchema(&running, &peak).Concurrent(n)
+
+				for i := 0; i < 10; i++ {
+					res, err := schema.Validate(concurrencyTestInput)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !reflect.DeepEqual(pathCodes(res), pathCodes(sequential)) {
+						t.Fatalf("errors = %v, want %v", pathCodes(res), pathCodes(sequential))
+					}
+				}
+				if p := peak.Load(); p > int64(n) || p < 2 {
+					t.Errorf("peak concurrency = %d, want between 2 and %d", p, n)
+				}
+			},
+		)
+	}
+}
+
+func TestSchemaConcurrent_FailFast(t *testing.T) {
+	var running, peak atomic.Int64
+
+	want, err := concurrencyTestSchema(&running, &peak).FailFast().Validate(concurrencyTestInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schema := concurrencyTestSchema(&running, &peak).FailFast().Concurrent(8)
+	for i := 0; i < 10; i++ {
+		res, err := schema.Validate(concurrencyTestInput)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(pathCodes(res), pathCodes(want)) {
+			t.Fatalf("errors = %v, want %v", pathCodes(res), pathCodes(want))
+		}
+	}
+}
+
+func TestSchemaConcurrent_RuleSyntaxError(t *testing.T) {
+	var running, peak atomic.Int64
+
+	schema := New().
+		Field("a", slowRule(&running, &peak)).
+		Field("b", Regex(`(`)).
+		Field("c", slowRule(&running, &peak), MultipleOf[int](0)).
+		Concurrent(3)
+
+	for i := 0; i < 10; i++ {
+		_, err := schema.Validate(map[string]any{"a": "x", "b": "y", "c": 3})
+		var rse RuleSyntaxError
+		if !errors.As(err, &rse) || rse.Rule != "Regex" {
+			t.Fatalf("error = %v, want the Regex RuleSyntaxError", err)
+		}
+	}
+}
@@ -134,6 +134,7 @@ type condParser struct {
 	tokens []cTok
 	pos    int
 	input  *InputBag
+	check  bool // see checkCondition
 }
 
 func (p *condParser) peek() cTok {
@@ -259,6 +260,9 @@ func (p *condParser) parseAtom() (any, error) {
 		}
 
 		p.consume()
+		if p.check {
+			return true, nil
+		}
 		val, _ := p.input.Lookup(t.val)
 		return val, nil
 	case cTokString:
@@ -296,6 +300,13 @@ func (p *condParser) parseCall() (any, error) {
 	}
 
 	p.consume()
+	switch {
+	case p.check && name == "exists":
+		return true, nil
+	case p.check && name == "len":
+		return 0, nil
+	}
+
 	switch name {
 	case "exists":
 		_, ok := p.input.Lookup(arg)
@@ -404,12 +415,24 @@ func condToFloat(v any) (float64, bool) {
 }
 
 func evalCondition(condition string, input *InputBag) (bool, error) {
+	return parseCondition(condition, &condParser{input: input})
+}
+
+// checkCondition reports the errors a condition fails with whatever the input, so malformed conditions can be rejected
+// when a schema is loaded rather than on every validation. Field paths are taken to hold true.
+func checkCondition(condition string) error {
+	_, err := parseCondition(condition, &condParser{check: true})
+
+	return err
+}
+
+func parseCondition(condition string, p *condParser) (bool, error) {
 	tokens, err := condTokenize(condition)
 	if err != nil {
 		return false, err
 	}
 
-	p := &condParser{tokens: tokens, input: input}
+	p.tokens = tokens
 	result, err := p.parseOr()
 	if err != nil {
 		return false, err
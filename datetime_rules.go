@@ -48,7 +48,7 @@ var timeFormats = []string{
 //	validation.After(deadline).Validate("not-a-date")           // fail
 func After(ct time.Time) Rule {
 	fn := func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return afterError{Time: ct}
 		}
@@ -82,7 +82,7 @@ func After(ct time.Time) Rule {
 func AfterField(path string) InputRule {
 	return InputRuleFunc(
 		func(value any, input *InputBag) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok {
 				return afterFieldError{Field: path}
 			}
@@ -97,7 +97,7 @@ func AfterField(path string) InputRule {
 				return afterFieldError{Field: path}
 			}
 
-			otherStr, ok := otherRaw.(string)
+			otherStr, ok := asString(otherRaw)
 			if !ok {
 				return afterFieldError{Field: path}
 			}
@@ -128,7 +128,7 @@ func AfterField(path string) InputRule {
 func AfterOrEqual(ct time.Time) Rule {
 	return RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok {
 				return afterOrEqualError{Time: ct}
 			}
@@ -162,7 +162,7 @@ func AfterOrEqual(ct time.Time) Rule {
 //	validation.Before(expiry).Validate("not-a-date")           // fail
 func Before(ct time.Time) Rule {
 	fn := func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return beforeError{Time: ct}
 		}
@@ -196,7 +196,7 @@ func Before(ct time.Time) Rule {
 func BeforeField(path string) InputRule {
 	return InputRuleFunc(
 		func(value any, input *InputBag) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok {
 				return beforeFieldError{Field: path}
 			}
@@ -211,7 +211,7 @@ func BeforeField(path string) InputRule {
 				return beforeFieldError{Field: path}
 			}
 
-			otherStr, ok := otherRaw.(string)
+			otherStr, ok := asString(otherRaw)
 			if !ok {
 				return beforeFieldError{Field: path}
 			}
@@ -242,7 +242,7 @@ func BeforeField(path string) InputRule {
 func BeforeOrEqual(ct time.Time) Rule {
 	return RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok {
 				return beforeOrEqualError{Time: ct}
 			}
@@ -273,7 +273,7 @@ func BeforeOrEqual(ct time.Time) Rule {
 //	validation.DateTime.Validate("not-a-date")              // fail
 var DateTime Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return basicError{"date_time", "datetime validation failed"}
 		}
@@ -305,7 +305,7 @@ var DateTime Rule = RuleFunc(
 func DateTimeBetween(minV, maxV time.Time) Rule {
 	return RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok {
 				return dateTimeBetweenError{Min: minV, Max: maxV}
 			}
@@ -337,7 +337,7 @@ func DateTimeBetween(minV, maxV time.Time) Rule {
 //	validation.DateTimeFormat("2006-01-02").Validate("not-a-date")           // fail
 func DateTimeFormat(layout string) Rule {
 	fn := func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return dateTimeFormatError{Format: layout}
 		}
@@ -369,7 +369,7 @@ func DateTimeFormat(layout string) Rule {
 //	validation.Timezone.Validate("InvalidZone")       // fail
 var Timezone Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || str == "" {
 			return basicError{"timezone", "timezone validation failed"}
 		}
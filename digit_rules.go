@@ -24,7 +24,7 @@ func Digits(n int) Rule {
 
 	return RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || !re.MatchString(str) {
 				return digitsError{Digits: n}
 			}
@@ -52,7 +52,7 @@ func DigitsBetween(minV, maxV int) Rule {
 
 	return RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || !re.MatchString(str) {
 				return digitsBetweenError{Min: minV, Max: maxV}
 			}
@@ -78,7 +78,7 @@ func MaxDigits(n int) Rule {
 
 	return RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || !re.MatchString(str) {
 				return maxDigitsError{Digits: n}
 			}
@@ -104,7 +104,7 @@ func MinDigits(n int) Rule {
 
 	return RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || !re.MatchString(str) {
 				return minDigitsError{Digits: n}
 			}
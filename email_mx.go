@@ -0,0 +1,186 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MXResolver looks up the MX records of a domain. *net.Resolver satisfies it; tests can supply a fake to validate
+// email domains offline.
+type MXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// EmailMXOptions configures a rule built by EmailMXWith. The zero value performs an uncached lookup per value, bounded
+// only by the validation context.
+type EmailMXOptions struct {
+	// Timeout bounds each lookup. A lookup that times out fails with code "email_mx_timeout". Zero means no timeout
+	// beyond the validation context.
+	Timeout time.Duration
+
+	// PositiveTTL caches domains that have MX records for this long. Zero disables caching of positive answers.
+	PositiveTTL time.Duration
+
+	// NegativeTTL caches domains without MX records for this long. Zero disables caching of negative answers.
+	// Timeouts, other resolver failures, and context errors are never cached.
+	NegativeTTL time.Duration
+}
+
+// EmailMXWith returns a Rule like EmailMX that resolves MX records through resolver, or net.DefaultResolver when
+// resolver is nil.
+//
+// A domain whose only MX record is the null MX "." (RFC 7505) accepts no mail and fails like a domain without records.
+// Answers may be cached per domain, case-insensitively, as configured by opts. Build the rule once and share it: the
+// cache belongs to the returned rule and is safe for concurrent use.
+//
+// Fails if:
+//   - value is not a string or is not a valid email format (code "email")
+//   - the domain has no MX records (code "email_mx")
+//   - the lookup times out (code "email_mx_timeout")
+//   - the lookup fails for another reason, such as a server failure (code "email_mx_unavailable")
+//
+// When the validation context is canceled or its deadline passes, the context's error is returned instead.
+//
+// Example:
+//
+//	emailMX := validation.EmailMXWith(nil, validation.EmailMXOptions{
+//		Timeout:     2 * time.Second,
+//		PositiveTTL: time.Hour,
+//		NegativeTTL: 5 * time.Minute,
+//	})
+func EmailMXWith(resolver MXResolver, opts EmailMXOptions) Rule {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	cache := &mxCache{now: time.Now, entries: make(map[string]mxCacheEntry)}
+
+	return ContextRuleFunc(
+		func(ctx context.Context, value any, _ *InputBag) error {
+			str, ok := asString(value)
+			if !ok || !isEmail(str) {
+				return basicError{"email", "email validation failed"}
+			}
+
+			domain := strings.ToLower(strings.SplitN(str, "@", 2)[1])
+			if found, ok := cache.get(domain); ok {
+				return mxResult(found)
+			}
+
+			found, err := lookupMX(ctx, resolver, domain, opts.Timeout)
+			if err != nil {
+				return err
+			}
+
+			if found {
+				cache.set(domain, true, opts.PositiveTTL)
+			} else {
+				cache.set(domain, false, opts.NegativeTTL)
+			}
+
+			return mxResult(found)
+		},
+	)
+}
+
+// lookupMX reports whether domain has usable MX records. Only a not-found answer or an empty one means the domain has
+// none. It returns the context's error when ctx ends, an "email_mx_timeout" error when the lookup itself times out, and
+// an "email_mx_unavailable" error when it fails otherwise.
+func lookupMX(ctx context.Context, resolver MXResolver, domain string, timeout time.Duration) (bool, error) {
+	lookupCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		lookupCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	records, err := resolver.LookupMX(lookupCtx, domain)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return false, ctxErr
+	}
+	if err != nil {
+		var dnsErr *net.DNSError
+		isDNSErr := errors.As(err, &dnsErr)
+		switch {
+		case errors.Is(err, context.DeadlineExceeded) || (isDNSErr && dnsErr.IsTimeout):
+			return false, basicError{"email_mx_timeout", "email mx lookup timed out"}
+		case isDNSErr && dnsErr.IsNotFound:
+			return false, nil
+		default:
+			return false, basicError{"email_mx_unavailable", "email mx lookup failed"}
+		}
+	}
+
+	for _, mx := range records {
+		if mx.Host != "." && mx.Host != "" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func mxResult(found bool) error {
+	if !found {
+		return basicError{"email_mx", "email mx validation failed"}
+	}
+
+	return nil
+}
+
+// mxCacheSweepSize is the cache size at which expired entries are first swept out.
+const mxCacheSweepSize = 1024
+
+// mxCache holds per-domain MX answers until they expire.
+type mxCache struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	entries map[string]mxCacheEntry
+	sweepAt int
+}
+
+type mxCacheEntry struct {
+	found   bool
+	expires time.Time
+}
+
+func (c *mxCache) get(domain string) (found, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[domain]
+	if !ok {
+		return false, false
+	}
+	if !c.now().Before(e.expires) {
+		delete(c.entries, domain)
+		return false, false
+	}
+
+	return e.found, true
+}
+
+func (c *mxCache) set(domain string, found bool, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.entries[domain] = mxCacheEntry{found: found, expires: now.Add(ttl)}
+
+	// Sweep expired entries whenever the cache doubles, so domains looked up only once do not accumulate.
+	if len(c.entries) >= max(c.sweepAt, mxCacheSweepSize) {
+		for d, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, d)
+			}
+		}
+		c.sweepAt = 2 * len(c.entries)
+	}
+}
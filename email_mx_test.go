@@ -0,0 +1,186 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeMXResolver struct {
+	mu      sync.Mutex
+	records map[string][]*net.MX
+	errs    map[string]error
+	calls   map[string]int
+	delay   time.Duration
+}
+
+func (r *fakeMXResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	r.mu.Lock()
+	r.calls[name]++
+	records, ok := r.records[name]
+	err := r.errs[name]
+	r.mu.Unlock()
+
+	if r.delay > 0 {
+		select {
+		case <-time.After(r.delay):
+		case <-ctx.Done():
+			return nil, &net.DNSError{Err: "i/o timeout", Name: name, IsTimeout: true}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+
+	return records, nil
+}
+
+func newFakeMXResolver() *fakeMXResolver {
+	return &fakeMXResolver{
+		records: map[string][]*net.MX{
+			"example.com": {{Host: "mx.example.com.", Pref: 10}},
+			"nomail.com":  {{Host: ".", Pref: 0}},
+			"empty.com":   {},
+		},
+		errs: map[string]error{
+			"servfail.com": &net.DNSError{Err: "server misbehaving", Name: "servfail.com", IsTemporary: true},
+		},
+		calls: make(map[string]int),
+	}
+}
+
+func TestEmailMXWith(t *testing.T) {
+	rule := EmailMXWith(newFakeMXResolver(), EmailMXOptions{})
+
+	tests := []struct {
+		value    any
+		wantCode string
+	}{
+		{"user@example.com", ""},
+		{"user@EXAMPLE.com", ""},
+		{"user@missing.com", "email_mx"},
+		{"user@nomail.com", "email_mx"}, // null MX
+		{"user@empty.com", "email_mx"},
+		{"user@servfail.com", "email_mx_unavailable"},
+		{"not an email", "email"},
+		{42, "email"},
+	}
+	for _, tt := range tests {
+		if code := errorCode(rule.Validate(tt.value)); code != tt.wantCode {
+			t.Errorf("Validate(%v) code = %q, want %q", tt.value, code, tt.wantCode)
+		}
+	}
+}
+
+func TestEmailMXWith_Timeout(t *testing.T) {
+	resolver := newFakeMXResolver()
+	resolver.delay = time.Second
+
+	rule := EmailMXWith(resolver, EmailMXOptions{Timeout: 10 * time.Millisecond, NegativeTTL: time.Hour})
+
+	for i := 0; i < 2; i++ {
+		if code := errorCode(rule.Validate("user@example.com")); code != "email_mx_timeout" {
+			t.Errorf("Validate code = %q, want email_mx_timeout", code)
+		}
+	}
+	if calls := resolver.calls["example.com"]; calls != 2 {
+		t.Errorf("lookups = %d, want 2 (timeouts are not cached)", calls)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	noTimeout := EmailMXWith(resolver, EmailMXOptions{})
+	err := noTimeout.(ContextRule).ValidateContext(ctx, "user@example.com", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ValidateContext past the context deadline error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestEmailMXWith_TemporaryError(t *testing.T) {
+	resolver := newFakeMXResolver()
+	rule := EmailMXWith(resolver, EmailMXOptions{NegativeTTL: time.Hour})
+
+	for i := 0; i < 2; i++ {
+		if code := errorCode(rule.Validate("user@servfail.com")); code != "email_mx_unavailable" {
+			t.Errorf("Validate code = %q, want email_mx_unavailable", code)
+		}
+	}
+	if calls := resolver.calls["servfail.com"]; calls != 2 {
+		t.Errorf("lookups = %d, want 2 (failed lookups are not cached)", calls)
+	}
+
+	resolver.mu.Lock()
+	delete(resolver.errs, "servfail.com")
+	resolver.records["servfail.com"] = []*net.MX{{Host: "mx.servfail.com.", Pref: 10}}
+	resolver.mu.Unlock()
+	if err := rule.Validate("user@servfail.com"); err != nil {
+		t.Errorf("Validate after the resolver recovered: %v", err)
+	}
+}
+
+func TestEmailMXWith_Cache(t *testing.T) {
+	resolver := newFakeMXResolver()
+	rule := EmailMXWith(resolver, EmailMXOptions{PositiveTTL: time.Minute, NegativeTTL: time.Second})
+
+	for i := 0; i < 3; i++ {
+		_ = rule.Validate("a@example.com")
+		_ = rule.Validate("b@Example.com")
+		_ = rule.Validate("a@missing.com")
+	}
+	if got := resolver.calls["example.com"]; got != 1 {
+		t.Errorf("example.com lookups = %d, want 1", got)
+	}
+	if got := resolver.calls["missing.com"]; got != 1 {
+		t.Errorf("missing.com lookups = %d, want 1", got)
+	}
+}
+
+func TestMXCache_Expiry(t *testing.T) {
+	now := time.Now()
+	c := &mxCache{now: func() time.Time { return now }, entries: make(map[string]mxCacheEntry)}
+
+	c.set("a.com", true, time.Minute)
+	c.set("b.com", false, time.Second)
+	c.set("c.com", true, 0)
+
+	if found, ok := c.get("a.com"); !ok || !found {
+		t.Errorf("get(a.com) = %v, %v; want true, true", found, ok)
+	}
+	if found, ok := c.get("b.com"); !ok || found {
+		t.Errorf("get(b.com) = %v, %v; want false, true", found, ok)
+	}
+	if _, ok := c.get("c.com"); ok {
+		t.Error("zero TTL should not be cached")
+	}
+
+	now = now.Add(2 * time.Second)
+	if _, ok := c.get("b.com"); ok {
+		t.Error("b.com should have expired")
+	}
+	if _, ok := c.get("a.com"); !ok {
+		t.Error("a.com should still be cached")
+	}
+
+}
+
+func TestMXCache_Sweep(t *testing.T) {
+	now := time.Now()
+	c := &mxCache{now: func() time.Time { return now }, entries: make(map[string]mxCacheEntry)}
+
+	for i := 0; i < mxCacheSweepSize-1; i++ {
+		c.set(strconv.Itoa(i)+".com", false, time.Second)
+	}
+	now = now.Add(time.Hour)
+	c.set("fresh.com", true, time.Minute)
+
+	if len(c.entries) != 1 {
+		t.Errorf("entries after sweep = %d, want 1", len(c.entries))
+	}
+}
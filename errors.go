@@ -1,6 +1,9 @@
 package validation
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Error is implemented by every validation-failure error returned by the rules in this package.
 // Code returns a stable snake_case key suitable for i18n catalog lookups.
@@ -25,6 +28,23 @@ func (e basicError) Error() string        { return e.message }
 func (e basicError) Code() string         { return e.code }
 func (basicError) Params() map[string]any { return nil }
 
+// ================================================================================================================== //
+//                                                    nestedError                                                     //
+// ================================================================================================================== //
+
+// nestedError is returned by rules that validate inside the value, such as Nested. It carries the failures found
+// beneath the field, with paths relative to it; Schema.Validate reports each of them as its own FieldError with the
+// field path prefixed.
+type nestedError struct {
+	code    string
+	message string
+	errors  []FieldError
+}
+
+func (e nestedError) Error() string        { return e.message }
+func (e nestedError) Code() string         { return e.code }
+func (nestedError) Params() map[string]any { return nil }
+
 // ================================================================================================================== //
 //                                                   containsError                                                    //
 // ================================================================================================================== //
@@ -369,6 +389,36 @@ func (neqError) Error() string            { return "neq validation failed" }
 func (neqError) Code() string             { return "neq" }
 func (e neqError) Params() map[string]any { return map[string]any{"value": e.Value} }
 
+// ================================================================================================================== //
+//                                                     typeError                                                      //
+// ================================================================================================================== //
+
+type typeError struct{ Type string }
+
+func (typeError) Error() string            { return "type validation failed" }
+func (typeError) Code() string             { return "type" }
+func (e typeError) Params() map[string]any { return map[string]any{"type": e.Type} }
+
+// ================================================================================================================== //
+//                                                  maxFileSizeError                                                  //
+// ================================================================================================================== //
+
+type maxFileSizeError struct{ Size int64 }
+
+func (maxFileSizeError) Error() string            { return "max file size validation failed" }
+func (maxFileSizeError) Code() string             { return "max_file_size" }
+func (e maxFileSizeError) Params() map[string]any { return map[string]any{"size": e.Size} }
+
+// ================================================================================================================== //
+//                                                   fileTypeError                                                    //
+// ================================================================================================================== //
+
+type fileTypeError struct{ Types []string }
+
+func (fileTypeError) Error() string            { return "file type validation failed" }
+func (fileTypeError) Code() string             { return "file_type" }
+func (e fileTypeError) Params() map[string]any { return map[string]any{"types": e.Types} }
+
 // ================================================================================================================== //
 //                                                    sameAsError                                                     //
 // ================================================================================================================== //
@@ -401,6 +451,10 @@ type FieldError struct {
 	Message string
 	Code    string
 	Params  map[string]any
+	// Label is the name of the field in messages, set with Schema.Label; empty when the field has none.
+	Label string
+
+	custom bool // Message comes from Schema.Messages
 }
 
 // Error implements the error interface.
@@ -458,3 +512,66 @@ func (r *Result) For(path string) []FieldError {
 	}
 	return out
 }
+
+// ByField returns the messages of the errors grouped by path, in the order they were reported.
+//
+// Example:
+//
+//	res.ByField() // map[address.city:[required validation failed] name:[min length validation failed]]
+func (r *Result) ByField() map[string][]string {
+	out := make(map[string][]string)
+	for _, fe := range r.errors {
+		out[fe.Path] = append(out[fe.Path], fe.Message)
+	}
+	return out
+}
+
+// treeOwnErrors is the key under which Tree keeps the messages of a path that also has errors below it.
+const treeOwnErrors = "_errors"
+
+// Tree returns the messages of the errors nested according to their dot paths, the shape form libraries expect:
+//
+//	{"address": {"city": ["required validation failed"]}, "tags": {"1": ["slug validation failed"]}}
+//
+// Each path segment, including slice indexes, becomes an object key, and the messages of a path are a list at its
+// leaf. When a path has errors of its own and errors below it, such as an Each rule on "tags" together with errors on
+// its elements, its own messages are kept under the "_errors" key.
+func (r *Result) Tree() map[string]any {
+	tree := make(map[string]any)
+	for _, fe := range r.errors {
+		segments := strings.Split(fe.Path, ".")
+		node := tree
+		for _, segment := range segments[:len(segments)-1] {
+			node = treeChild(node, segment)
+		}
+
+		leaf := segments[len(segments)-1]
+		switch v := node[leaf].(type) {
+		case map[string]any:
+			own, _ := v[treeOwnErrors].([]string)
+			v[treeOwnErrors] = append(own, fe.Message)
+		case []string:
+			node[leaf] = append(v, fe.Message)
+		default:
+			node[leaf] = []string{fe.Message}
+		}
+	}
+	return tree
+}
+
+// treeChild returns the object under key in node, creating it, or turning a list of messages there into an object
+// holding them under "_errors".
+func treeChild(node map[string]any, key string) map[string]any {
+	switch v := node[key].(type) {
+	case map[string]any:
+		return v
+	case []string:
+		child := map[string]any{treeOwnErrors: v}
+		node[key] = child
+		return child
+	default:
+		child := make(map[string]any)
+		node[key] = child
+		return child
+	}
+}
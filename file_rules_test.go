@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMaxFileSize(t *testing.T) {
+	form := testMultipartForm(t, nil, map[string][]string{"small": {"abc"}, "big": {strings.Repeat("x", 100)}})
+	rule := MaxFileSize(10)
+
+	if err := rule.Validate(form.File["small"][0]); err != nil {
+		t.Errorf("small file: %v", err)
+	}
+	if err := rule.Validate(form.File["big"][0]); err == nil {
+		t.Error("big file: expected error")
+	}
+	if err := rule.Validate("abc"); err == nil {
+		t.Error("non-file: expected error")
+	}
+}
+
+func TestFileType(t *testing.T) {
+	form := testMultipartForm(
+		t, nil, map[string][]string{
+			"png":  {pngHeader},
+			"pdf":  {"%PDF-1.4\n"},
+			"text": {"just text"},
+		},
+	)
+
+	tests := []struct {
+		name    string
+		file    string
+		types   []string
+		wantErr bool
+	}{
+		{"exact", "png", []string{"image/png"}, false},
+		{"family", "png", []string{"image/*"}, false},
+		{"one of several", "pdf", []string{"image/*", "application/pdf"}, false},
+		{"renamed text", "text", []string{"image/*"}, true},
+		{"text", "text", []string{"text/plain"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				err := FileType(tt.types...).Validate(form.File[tt.file][0])
+				if (err != nil) != tt.wantErr {
+					t.Errorf("FileType(%v) error = %v, wantErr %v", tt.types, err, tt.wantErr)
+				}
+			},
+		)
+	}
+
+	if err := FileType("image/*").Validate("avatar.png"); err == nil {
+		t.Error("non-file: expected error")
+	}
+
+	rules, err := ParseRules("required|file_type:image/png|max_file_size:1024")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := New().Field("avatar", rules...).Validate(FromMultipart(form))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.For("avatar")) != 1 {
+		t.Errorf("errors = %v, want avatar missing", res.Errors())
+	}
+}
@@ -6,7 +6,7 @@ import "reflect"
 //
 // Fails if:
 //   - value is nil
-//   - value is a string equal to ""
+//   - value is an empty string, including named string types such as `type Email string`, or an empty []byte
 //
 // Passes for any other type, including zero values such as 0 or false — use NotEmpty if those should also be rejected.
 //
@@ -15,26 +15,21 @@ import "reflect"
 //	schema := validation.New().
 //		Field("name", validation.Required).
 //		Field("email", validation.Required, validation.Email)
-var Required Rule = presenceRuleFunc(
+var Required Rule = describe(describeRequired, presenceRuleFunc(
 	func(value any) error {
-		if value == nil {
-			return basicError{"required", "required validation failed"}
-		}
-
-		s, ok := value.(string)
-		if ok && s == "" {
+		if isMissing(value) {
 			return basicError{"required", "required validation failed"}
 		}
 
 		return nil
 	},
-)
+))
 
 // RequiredIf returns a Rule that validates the value exists if the condition evaluated to true.
 //
 // Fails if:
 //   - the condition is true AND value is nil
-//   - the condition is true AND value is a string equal to ""
+//   - the condition is true AND value is an empty string or []byte, as for Required
 //
 // Returns RuleSyntaxError if the condition string is malformed — treat that as a programming error and fix the schema
 // at startup.
@@ -66,10 +61,7 @@ func RequiredIf(condition string) InputRule {
 		}
 
 		if ok {
-			if value == nil {
-				return basicError{"required_if", "required if validation failed"}
-			}
-			if s, isStr := value.(string); isStr && s == "" {
+			if isMissing(value) {
 				return basicError{"required_if", "required if validation failed"}
 			}
 		}
@@ -99,10 +91,7 @@ func RequiredUnless(condition string) InputRule {
 		}
 
 		if !ok {
-			if value == nil {
-				return basicError{"required_unless", "required unless validation failed"}
-			}
-			if s, isStr := value.(string); isStr && s == "" {
+			if isMissing(value) {
 				return basicError{"required_unless", "required unless validation failed"}
 			}
 		}
@@ -125,10 +114,7 @@ func RequiredWith(fields ...string) InputRule {
 		func(value any, input *InputBag) error {
 			for _, f := range fields {
 				if _, found := input.Lookup(f); found {
-					if value == nil {
-						return basicError{"required_with", "required with validation failed"}
-					}
-					if s, ok := value.(string); ok && s == "" {
+					if isMissing(value) {
 						return basicError{"required_with", "required with validation failed"}
 					}
 
@@ -158,10 +144,7 @@ func RequiredWithAll(fields ...string) InputRule {
 				}
 			}
 
-			if value == nil {
-				return basicError{"required_with_all", "required with all validation failed"}
-			}
-			if s, ok := value.(string); ok && s == "" {
+			if isMissing(value) {
 				return basicError{"required_with_all", "required with all validation failed"}
 			}
 
@@ -183,10 +166,7 @@ func RequiredWithout(fields ...string) InputRule {
 		func(value any, input *InputBag) error {
 			for _, f := range fields {
 				if _, found := input.Lookup(f); !found {
-					if value == nil {
-						return basicError{"required_without", "required without validation failed"}
-					}
-					if s, ok := value.(string); ok && s == "" {
+					if isMissing(value) {
 						return basicError{"required_without", "required without validation failed"}
 					}
 
@@ -216,10 +196,7 @@ func RequiredWithoutAll(fields ...string) InputRule {
 				}
 			}
 
-			if value == nil {
-				return basicError{"required_without_all", "required without all validation failed"}
-			}
-			if s, ok := value.(string); ok && s == "" {
+			if isMissing(value) {
 				return basicError{"required_without_all", "required without all validation failed"}
 			}
 
@@ -228,6 +205,17 @@ func RequiredWithoutAll(fields ...string) InputRule {
 	)
 }
 
+// isMissing reports whether value counts as absent for Required and its conditional variants: nil, or an empty string
+// of any string type, or an empty []byte.
+func isMissing(value any) bool {
+	if value == nil {
+		return true
+	}
+	s, ok := asString(value)
+
+	return ok && s == ""
+}
+
 // NotEmpty is a Rule that validates the value is not an empty or zero value.
 //
 // Fails if:
@@ -6,6 +6,8 @@ import (
 )
 
 func TestRequired(t *testing.T) {
+	type email string
+
 	tests := []struct {
 		value   any
 		wantErr bool
@@ -14,8 +16,13 @@ func TestRequired(t *testing.T) {
 		{"0", false},
 		{0, false},
 		{false, false},
+		{email("a@b.com"), false},
+		{[]byte("x"), false},
 		{nil, true},
 		{"", true},
+		{email(""), true},
+		{[]byte{}, true},
+		{[]byte(nil), true},
 	}
 	for _, tt := range tests {
 		err := Required.Validate(tt.value)
@@ -306,6 +313,30 @@ func TestRequiredWithoutAll(t *testing.T) {
 	}
 }
 
+func TestRequiredVariants_EmptyValues(t *testing.T) {
+	type email string
+
+	input := NewInputBag(map[string]any{"role": "admin", "phone": "123"})
+	rules := map[string]Rule{
+		"required_if":          RequiredIf(`role == "admin"`),
+		"required_unless":      RequiredUnless(`role == "guest"`),
+		"required_with":        RequiredWith("phone"),
+		"required_with_all":    RequiredWithAll("role", "phone"),
+		"required_without":     RequiredWithout("email"),
+		"required_without_all": RequiredWithoutAll("email", "mobile"),
+	}
+	for code, rule := range rules {
+		for _, value := range []any{"", email(""), []byte{}} {
+			if got := errorCode(applyRule(rule, value, input)); got != code {
+				t.Errorf("%s: Validate(%#v) code = %q, want %q", code, value, got, code)
+			}
+		}
+		if err := applyRule(rule, email("a@b.com"), input); err != nil {
+			t.Errorf("%s: Validate(email) error = %v", code, err)
+		}
+	}
+}
+
 func TestNotEmpty(t *testing.T) {
 	tests := []struct {
 		value   any
@@ -27,7 +27,7 @@ func In[T comparable](slice []T) Rule {
 		return nil
 	}
 
-	return RuleFunc(fn)
+	return withKeywords("", map[string]any{"enum": slice}, RuleFunc(fn))
 }
 
 // NEQ returns a Rule that validates the value is not equal to v.
@@ -45,7 +45,7 @@ func In[T comparable](slice []T) Rule {
 //	validation.NEQ[int](0).Validate(1)               // pass
 //	validation.NEQ[int](0).Validate(0)               // fail
 func NEQ[T comparable](v T) Rule {
-	return RuleFunc(
+	return withKeywords("", map[string]any{"not": map[string]any{"const": v}}, RuleFunc(
 		func(value any) error {
 			actual, ok := value.(T)
 			if !ok || actual == v {
@@ -54,7 +54,7 @@ func NEQ[T comparable](v T) Rule {
 
 			return nil
 		},
-	)
+	))
 }
 
 // NotIn returns a Rule that validates the value is not present in the given slice.
@@ -81,5 +81,5 @@ func NotIn[T comparable](slice []T) Rule {
 		return nil
 	}
 
-	return RuleFunc(fn)
+	return withKeywords("", map[string]any{"not": map[string]any{"enum": slice}}, RuleFunc(fn))
 }
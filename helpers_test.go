@@ -9,3 +9,12 @@ func errorCode(err error) string {
 	}
 	return ""
 }
+
+// pathCodes returns the errors of res as "path:code" strings, in order.
+func pathCodes(res *Result) []string {
+	var got []string
+	for _, fe := range res.Errors() {
+		got = append(got, fe.Path+":"+fe.Code)
+	}
+	return got
+}
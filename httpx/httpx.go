@@ -0,0 +1,189 @@
+// Package httpx connects validation schemas to net/http handlers. It decodes JSON request bodies, validates them, and
+// answers invalid requests with an RFC 7807 problem details response, so every service rejects bad input the same
+// way.
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/behzadsh/go.validator/v2"
+)
+
+// DefaultMaxBodyBytes is the request body limit used when Options.MaxBodyBytes is zero.
+const DefaultMaxBodyBytes = 1 << 20
+
+// Options configure Middleware and Decode.
+type Options struct {
+	// MaxBodyBytes limits the size of the request body; a larger body is answered with 413. Zero means
+	// DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+
+	// Locale selects the locale validation messages are rendered in, as with Result.Localize. Nil leaves the
+	// messages as the schema produced them. AcceptLanguage is a ready-made choice.
+	Locale func(r *http.Request) string
+}
+
+type inputKey struct{}
+
+// Middleware returns middleware that decodes the JSON body of every request and validates it with schema.
+//
+// A valid request is passed on with the sanitized input (see Schema.Process) stored in its context, where Input
+// retrieves it. Otherwise the middleware responds with an application/problem+json body and does not call the next
+// handler:
+//   - 415 when the Content-Type is set and is not JSON
+//   - 413 when the body exceeds Options.MaxBodyBytes
+//   - 400 when the body is not a single JSON object, including null
+//   - 422 with the field errors when validation fails (see Result.Problem)
+//   - 503 when the request context ends before validation completes
+//   - 500 when the schema is misconfigured
+//
+// An empty body is validated as an empty object.
+//
+// Example:
+//
+//	mux.Handle("POST /users", httpx.Middleware(userSchema, httpx.Options{Locale: httpx.AcceptLanguage})(createUser))
+//
+//	func createUser(w http.ResponseWriter, r *http.Request) {
+//		input, _ := httpx.Input(r.Context())
+//		...
+//	}
+func Middleware(schema *validation.Schema, opts Options) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				input, ok := Decode(w, r, schema, opts)
+				if !ok {
+					return
+				}
+				next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), inputKey{}, input)))
+			},
+		)
+	}
+}
+
+// Decode is the per-handler form of Middleware. It decodes and validates the body of r and returns the sanitized
+// input. When the request is rejected, Decode has already written the response and reports false.
+//
+// Example:
+//
+//	input, ok := httpx.Decode(w, r, userSchema, httpx.Options{})
+//	if !ok {
+//		return
+//	}
+func Decode(w http.ResponseWriter, r *http.Request, schema *validation.Schema, opts Options) (map[string]any, bool) {
+	body, status, detail := readBody(w, r, opts)
+	if status != 0 {
+		writeProblem(w, r, status, detail)
+		return nil, false
+	}
+
+	input, res, err := schema.ProcessContext(r.Context(), body)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			writeProblem(w, r, http.StatusServiceUnavailable, "The request could not be validated in time.")
+		} else {
+			writeProblem(w, r, http.StatusInternalServerError, "")
+		}
+		return nil, false
+	}
+
+	if res.HasErrors() {
+		if opts.Locale != nil {
+			res = res.Localize(opts.Locale(r))
+		}
+		problem := res.Problem()
+		problem.Instance = r.URL.Path
+		_ = problem.Write(w)
+		return nil, false
+	}
+
+	return input, true
+}
+
+// Input returns the validated input that Middleware stored in ctx.
+func Input(ctx context.Context) (map[string]any, bool) {
+	input, ok := ctx.Value(inputKey{}).(map[string]any)
+
+	return input, ok
+}
+
+// AcceptLanguage returns the most preferred language of the request's Accept-Language header, e.g. "fa-IR" for
+// "fa-IR,fa;q=0.9,en;q=0.8", or "" when the header is absent.
+func AcceptLanguage(r *http.Request) string {
+	best, bestQ := "", -1.0
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		if q > bestQ {
+			best, bestQ = tag, q
+		}
+	}
+
+	return best
+}
+
+// readBody decodes the JSON object in the body of r. On failure it returns the response status and detail.
+func readBody(w http.ResponseWriter, r *http.Request, opts Options) (map[string]any, int, string) {
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		mediaType, _, err := mime.ParseMediaType(ct)
+		if err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) {
+			return nil, http.StatusUnsupportedMediaType, "The request body must be JSON."
+		}
+	}
+
+	limit := opts.MaxBodyBytes
+	if limit == 0 {
+		limit = DefaultMaxBodyBytes
+	}
+
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, limit))
+	var body map[string]any
+	err := dec.Decode(&body)
+	switch {
+	case err == nil && body == nil:
+		err = errors.New("the body is null")
+	case err == nil && dec.Decode(&struct{}{}) != io.EOF:
+		err = errors.New("unexpected data after the JSON object")
+	}
+
+	var tooLarge *http.MaxBytesError
+	switch {
+	case err == nil, errors.Is(err, io.EOF):
+		return body, 0, ""
+	case errors.As(err, &tooLarge):
+		return nil, http.StatusRequestEntityTooLarge, "The request body is too large."
+	default:
+		return nil, http.StatusBadRequest, "The request body must be a JSON object."
+	}
+}
+
+// writeProblem responds with a problem details body that carries no field errors.
+func writeProblem(w http.ResponseWriter, r *http.Request, status int, detail string) {
+	problem := &validation.Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: r.URL.Path,
+		Errors:   []validation.FieldError{},
+	}
+	_ = problem.Write(w)
+}
@@ -0,0 +1,141 @@
+package httpx
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	validation "github.com/behzadsh/go.validator/v2"
+)
+
+func TestMiddleware(t *testing.T) {
+	schema := validation.New().
+		Field("email", validation.Required, validation.Email).Sanitize(validation.Trim).
+		Field("name", validation.MinLength(2))
+
+	var got map[string]any
+	handler := Middleware(schema, Options{MaxBodyBytes: 64, Locale: AcceptLanguage})(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				got, _ = Input(r.Context())
+				w.WriteHeader(http.StatusNoContent)
+			},
+		),
+	)
+
+	tests := []struct {
+		name        string
+		contentType string
+		language    string
+		body        string
+		wantStatus  int
+		wantErrors  []string
+	}{
+		{"valid", "application/json", "", `{"email": " a@b.co "}`, http.StatusNoContent, nil},
+		{"invalid", "application/json; charset=utf-8", "", `{"email": "x", "name": "A"}`, 422, []string{
+			"email:email validation failed", "name:min length validation failed",
+		}},
+		{"localized", "", "fa-IR,en;q=0.5", `{}`, 422, []string{"email:الزامی است"}},
+		{"empty body", "application/json", "", ``, 422, []string{"email:required validation failed"}},
+		{"malformed", "application/json", "", `{"email": `, http.StatusBadRequest, nil},
+		{"not an object", "application/json", "", `["a@b.co"]`, http.StatusBadRequest, nil},
+		{"null", "application/json", "", `null`, http.StatusBadRequest, nil},
+		{"trailing data", "application/json", "", `{"email": "a@b.co"} {}`, http.StatusBadRequest, nil},
+		{"too large", "application/json", "", `{"email": "` + strings.Repeat("a", 64) + `"}`, 413, nil},
+		{"not json", "text/plain", "", `{"email": "a@b.co"}`, http.StatusUnsupportedMediaType, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got = nil
+				req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(tt.body))
+				if tt.contentType != "" {
+					req.Header.Set("Content-Type", tt.contentType)
+				}
+				if tt.language != "" {
+					req.Header.Set("Accept-Language", tt.language)
+				}
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, req)
+
+				if rec.Code != tt.wantStatus {
+					t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body)
+				}
+				if tt.wantStatus == http.StatusNoContent {
+					if got["email"] != "a@b.co" {
+						t.Errorf("input in context = %v, want the sanitized body", got)
+					}
+					return
+				}
+				if got != nil {
+					t.Error("next handler called for a rejected request")
+				}
+
+				if ct := rec.Header().Get("Content-Type"); ct != validation.ProblemContentType {
+					t.Errorf("content type = %q", ct)
+				}
+				var problem struct {
+					Status   int    `json:"status"`
+					Instance string `json:"instance"`
+					Errors   []struct {
+						Path    string `json:"path"`
+						Message string `json:"message"`
+					} `json:"errors"`
+				}
+				if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+					t.Fatal(err)
+				}
+				if problem.Status != tt.wantStatus || problem.Instance != "/users" {
+					t.Errorf("problem = %s", rec.Body)
+				}
+				var errs []string
+				for _, e := range problem.Errors {
+					errs = append(errs, e.Path+":"+e.Message)
+				}
+				if strings.Join(errs, "|") != strings.Join(tt.wantErrors, "|") {
+					t.Errorf("errors = %v, want %v", errs, tt.wantErrors)
+				}
+			},
+		)
+	}
+}
+
+func TestDecode_MisconfiguredSchema(t *testing.T) {
+	schema := validation.New().Field("code", validation.Regex(`(`))
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"code": "x"}`))
+	rec := httptest.NewRecorder()
+	if _, ok := Decode(rec, req, schema, Options{}); ok {
+		t.Fatal("Decode() reported success")
+	}
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", rec.Code)
+	}
+}
+
+func TestAcceptLanguage(t *testing.T) {
+	tests := map[string]string{
+		"":                           "",
+		"fa":                         "fa",
+		"en;q=0.8, fa-IR, fa;q=0.9":  "fa-IR",
+		"de;q=0.5,*;q=1,en-GB;q=0.7": "en-GB",
+		"fr;q=bogus, nl;q=0.1":       "nl",
+	}
+	for header, want := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Language", header)
+		if got := AcceptLanguage(req); got != want {
+			t.Errorf("AcceptLanguage(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestInput_Missing(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if _, ok := Input(req.Context()); ok {
+		t.Error("Input() found a value in a fresh context")
+	}
+}
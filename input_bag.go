@@ -1,18 +1,29 @@
 package validation
 
 import (
+	"context"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 )
 
+// wildcard is the path segment that matches every element of a slice or array and every key of a map.
+const wildcard = "*"
+
 // InputBag wraps the raw input passed to Schema.Validate and provides path-based field access. Paths use dot notation,
-// e.g. "user.profile.email", and can traverse nested maps, structs, and pointers in any combination.
+// e.g. "user.profile.email" or "items.0.sku", and can traverse nested maps, structs, slices, and pointers in any
+// combination.
 //
 // InputBag is constructed once per Validate call and then passed read-only to every InputRule. Callers outside the
 // validation package typically obtain one from the input parameter of InputRuleFunc — there is no need to construct
 // an InputBag directly.
 type InputBag struct {
-	input any
+	input     any
+	ctx       context.Context
+	partial   bool
+	sanitized map[*Schema]bool // sub-schemas whose values were sanitized along with the input
+	loose     bool
 }
 
 // NewInputBag wraps input in an InputBag. The input may be a map[string]any, a struct, a pointer to a struct,
@@ -21,6 +32,40 @@ func NewInputBag(input any) *InputBag {
 	return &InputBag{input: input}
 }
 
+// Context returns the context passed to Schema.ValidateContext, or context.Background when validation was started
+// without one. It is safe to call on a nil InputBag.
+func (b *InputBag) Context() context.Context {
+	if b == nil || b.ctx == nil {
+		return context.Background()
+	}
+
+	return b.ctx
+}
+
+// Partial reports whether the input is being validated with Schema.ValidatePartial, in which absent fields are not
+// checked. It is safe to call on a nil InputBag.
+func (b *InputBag) Partial() bool {
+	return b != nil && b.partial
+}
+
+// Loose reports whether numeric rules convert values instead of requiring their exact type, see Loose. It is safe to
+// call on a nil InputBag.
+func (b *InputBag) Loose() bool {
+	return b != nil && b.loose
+}
+
+// loosened returns a copy of b in loose mode.
+func (b *InputBag) loosened() *InputBag {
+	if b == nil {
+		return &InputBag{loose: true}
+	}
+
+	c := *b
+	c.loose = true
+
+	return &c
+}
+
 // Lookup resolves a dot-notation path against the wrapped input and returns the value at that path together with
 // a boolean indicating whether the path was found.
 //
@@ -31,10 +76,12 @@ func NewInputBag(input any) *InputBag {
 //   - map[string]any  → key lookup
 //   - any other map with string keys → reflect-based key lookup
 //   - struct or *struct → field resolved by json tag, then by Go field name
+//   - slice or array → element at the numeric index, e.g. "items.0"
 //   - pointer / interface → automatically dereferenced
 //
-// Returning false when any segment is missing or a non-traversable value, e.g. a scalar, is encountered before the path
-// is fully consumed.
+// Returning false when any segment is missing, an index is out of range, or a non-traversable value, e.g. a scalar, is
+// encountered before the path is fully consumed. The wildcard segment "*" is not expanded by Lookup; it is looked up as
+// a literal map key.
 func (b *InputBag) Lookup(path string) (any, bool) {
 	if path == "" {
 		return nil, false
@@ -52,8 +99,95 @@ func (b *InputBag) Lookup(path string) (any, bool) {
 	return current, true
 }
 
-// step advances one segment of a dot-notation path against the current value. It handles map[string]any directly for
-// performance, then falls back to reflection for other map types and structs.
+// expand resolves every wildcard segment of path against the wrapped input and returns the matching concrete paths in
+// input order; map keys are visited in sorted order. A path without wildcards is returned unchanged.
+//
+// Segments after the last wildcard are appended as-is, so "items.*.sku" yields "items.3.sku" for an element that lacks
+// a sku and Required can report it. A wildcard whose parent is absent or not a collection matches nothing.
+func (b *InputBag) expand(path string) []string {
+	segments := strings.Split(path, ".")
+	if !slices.Contains(segments, wildcard) {
+		return []string{path}
+	}
+
+	var paths []string
+	expandSegments(b.input, segments, nil, &paths)
+
+	return paths
+}
+
+func expandSegments(current any, segments, prefix []string, paths *[]string) {
+	for i, segment := range segments {
+		if !slices.Contains(segments[i:], wildcard) {
+			*paths = append(*paths, strings.Join(joinSegments(prefix, segments[i:]), "."))
+			return
+		}
+
+		if segment == wildcard {
+			for _, key := range childKeys(current) {
+				next, _ := step(current, key)
+				expandSegments(next, segments[i+1:], joinSegments(prefix, []string{key}), paths)
+			}
+			return
+		}
+
+		var ok bool
+		current, ok = step(current, segment)
+		if !ok {
+			return
+		}
+		prefix = joinSegments(prefix, []string{segment})
+	}
+
+	*paths = append(*paths, strings.Join(prefix, "."))
+}
+
+// joinSegments returns a new slice holding a followed by b, leaving both arguments untouched.
+func joinSegments(a, b []string) []string {
+	out := make([]string, 0, len(a)+len(b))
+	out = append(out, a...)
+	return append(out, b...)
+}
+
+// childKeys returns the path segments addressing every child of a slice, array, or string-keyed map. Other values have
+// no children.
+func childKeys(current any) []string {
+	if current == nil {
+		return nil
+	}
+
+	rv := reflect.ValueOf(current)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		keys := make([]string, rv.Len())
+		for i := range keys {
+			keys[i] = strconv.Itoa(i)
+		}
+		return keys
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		keys := make([]string, 0, rv.Len())
+		for _, k := range rv.MapKeys() {
+			keys = append(keys, k.String())
+		}
+		slices.Sort(keys)
+		return keys
+	default:
+		return nil
+	}
+}
+
+// step advances one segment of a dot-notation path against the current value. It handles map[string]any and []any
+// directly for performance, then falls back to reflection for other map types, slices, arrays, and structs.
 func step(current any, segment string) (any, bool) {
 	if current == nil {
 		return nil, false
@@ -64,6 +198,19 @@ func step(current any, segment string) (any, bool) {
 		return v, exists
 	}
 
+	if c, ok := current.(structCopy); ok {
+		key, exists := c.key(segment)
+		return c.fields[key], exists
+	}
+
+	if l, ok := current.([]any); ok {
+		i, ok := sliceIndex(segment, len(l))
+		if !ok {
+			return nil, false
+		}
+		return l[i], true
+	}
+
 	rv := reflect.ValueOf(current)
 	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
 		if rv.IsNil() {
@@ -84,11 +231,32 @@ func step(current any, segment string) (any, bool) {
 		return v.Interface(), true
 	case reflect.Struct:
 		return structField(rv, segment)
+	case reflect.Slice, reflect.Array:
+		i, ok := sliceIndex(segment, rv.Len())
+		if !ok {
+			return nil, false
+		}
+		return rv.Index(i).Interface(), true
 	default:
 		return nil, false
 	}
 }
 
+// sliceIndex parses segment as an index into a collection of length n. Only plain non-negative decimal numbers
+// within range are accepted.
+func sliceIndex(segment string, n int) (int, bool) {
+	if segment == "" || strings.TrimLeft(segment, "0123456789") != "" {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(segment)
+	if err != nil || i >= n {
+		return 0, false
+	}
+
+	return i, true
+}
+
 // structField resolves a single path segment against a struct value.
 //
 // Resolution order:
@@ -124,16 +292,7 @@ func structField(rv reflect.Value, name string) (any, bool) {
 		if !field.IsExported() {
 			continue
 		}
-		if tag, ok := field.Tag.Lookup("json"); ok {
-			tagName := strings.Split(tag, ",")[0]
-			if tagName == "-" {
-				continue
-			}
-			if tagName != "" && tagName == name {
-				return derefField(rv.Field(i))
-			}
-		}
-		if field.Name == name {
+		if fieldMatches(field, name) {
 			return derefField(rv.Field(i))
 		}
 	}
@@ -141,6 +300,14 @@ func structField(rv reflect.Value, name string) (any, bool) {
 	return nil, false
 }
 
+// fieldMatches reports whether a path segment names an exported struct field: by the name in its json tag, or by its
+// Go name. A field tagged json:"-" matches neither.
+func fieldMatches(field reflect.StructField, name string) bool {
+	jsonName, ok := jsonFieldName(field)
+
+	return ok && (jsonName == name || field.Name == name)
+}
+
 func derefField(fv reflect.Value) (any, bool) {
 	for fv.Kind() == reflect.Pointer {
 		if fv.IsNil() {
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -200,3 +201,76 @@ func TestInputBagLookup_NonStringKeyedMap(t *testing.T) {
 		t.Error("non-string-keyed map should not be traversable")
 	}
 }
+
+func TestInputBagLookup_Slice(t *testing.T) {
+	type Item struct {
+		SKU string `json:"sku"`
+	}
+
+	input := map[string]any{
+		"tags":  []any{"a", "b"},
+		"items": []Item{{SKU: "x1"}, {SKU: "x2"}},
+		"grid":  [2][]int{{1, 2}, {3, 4}},
+	}
+	bag := NewInputBag(input)
+
+	tests := []struct {
+		path      string
+		wantVal   any
+		wantFound bool
+	}{
+		{"tags.0", "a", true},
+		{"tags.1", "b", true},
+		{"tags.2", nil, false},
+		{"tags.-1", nil, false},
+		{"tags.+1", nil, false},
+		{"tags.x", nil, false},
+		{"items.1.sku", "x2", true},
+		{"items.2.sku", nil, false},
+		{"grid.1.0", 3, true},
+		{"tags.*", nil, false},
+	}
+
+	for _, tt := range tests {
+		val, found := bag.Lookup(tt.path)
+		if found != tt.wantFound {
+			t.Errorf("Lookup(%q) found = %v, want %v", tt.path, found, tt.wantFound)
+		}
+		if found && val != tt.wantVal {
+			t.Errorf("Lookup(%q) val = %v, want %v", tt.path, val, tt.wantVal)
+		}
+	}
+}
+
+func TestInputBagExpand(t *testing.T) {
+	input := map[string]any{
+		"items": []any{
+			map[string]any{"sku": "a", "tags": []string{"x", "y"}},
+			map[string]any{"name": "no sku"},
+		},
+		"prices": map[string]any{"usd": 1, "eur": 2},
+		"name":   "scalar",
+	}
+	bag := NewInputBag(input)
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"name", []string{"name"}},
+		{"missing.path", []string{"missing.path"}},
+		{"items.*", []string{"items.0", "items.1"}},
+		{"items.*.sku", []string{"items.0.sku", "items.1.sku"}},
+		{"items.*.tags.*", []string{"items.0.tags.0", "items.0.tags.1"}},
+		{"prices.*", []string{"prices.eur", "prices.usd"}},
+		{"name.*", nil},
+		{"missing.*.sku", nil},
+	}
+
+	for _, tt := range tests {
+		got := bag.expand(tt.path)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("expand(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonSchemaDialect is the meta-schema declared by Schema.JSONSchema.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema describes the schema as a JSON Schema (draft 2020-12) document, ready to be marshaled with encoding/json
+// and published alongside an API contract.
+//
+// Field paths become nested object properties, and "*" segments become array items. Built-in rules map to the
+// equivalent keywords:
+//   - Required → the "required" array of the enclosing object
+//   - MinLength, MaxLength, Length → minLength / maxLength
+//   - Regex → pattern
+//   - In, NotIn, NEQ → enum, not enum, not const
+//   - Min, Max, GT, GTE, LT, LTE, Between, MultipleOf, Positive, NonNegative, Negative → minimum, maximum,
+//     exclusiveMinimum, exclusiveMaximum, multipleOf
+//   - Integer → type integer
+//   - Email, UUID, URL, IPv4, IPv6 → format
+//   - MinSize, MaxSize, Size → minItems / maxItems
+//   - Each → items, Nested → an object schema, Any → anyOf, Not → not
+//
+// String rules also declare type string, and numeric rules type number. When several rules set the same keyword the
+// later one wins. Rules without a JSON Schema equivalent (custom rules, cross-field and conditional rules, and
+// checks such as CreditCard) are left out, so the document describes the schema rather than reproducing it exactly.
+// A self-referencing Nested schema is emitted once under $defs and referenced with $ref. Field labels become titles.
+//
+// Example:
+//
+//	doc, _ := json.MarshalIndent(schema.JSONSchema(), "", "  ")
+func (s *Schema) JSONSchema() map[string]any {
+	b := &jsonSchemaBuilder{
+		refs:   map[*Schema]string{s: "#"},
+		active: make(map[*Schema]bool),
+		defs:   make(map[string]*jsonSchemaNode),
+	}
+
+	root := newJSONSchemaNode()
+	b.addFields(root, s)
+
+	doc := root.document()
+	doc["$schema"] = jsonSchemaDialect
+	doc["type"] = "object"
+	if len(b.defs) > 0 {
+		defs := make(map[string]any, len(b.defs))
+		for name, n := range b.defs {
+			defs[name] = n.document()
+		}
+		doc["$defs"] = defs
+	}
+
+	return doc
+}
+
+// describeFunc records the JSON Schema keywords a rule enforces on the node describing the validated value.
+type describeFunc func(b *jsonSchemaBuilder, n *jsonSchemaNode)
+
+// describedRule pairs a rule with its JSON Schema description. Validation is delegated to the wrapped rule unchanged,
+// and a nil describe adds nothing to the document.
+//
+// The other fields expose what the wrapped rule applies, so sanitizers can reach sub-schemas through combinators:
+// schema is the sub-schema of Nested, inner holds the rules applied to the value itself, as by Any or When, and items
+// the rules applied to each element, as by Each.
+type describedRule struct {
+	rule     Rule
+	describe describeFunc
+	schema   *Schema
+	inner    []Rule
+	items    []Rule
+}
+
+func (d describedRule) Validate(value any) error { return d.rule.Validate(value) }
+func (d describedRule) ValidateWithInput(value any, input *InputBag) error {
+	return applyRule(d.rule, value, input)
+}
+
+// describedPresenceRule is a describedRule wrapping a presence rule, so it still runs for absent values.
+type describedPresenceRule struct {
+	describedRule
+}
+
+func (describedPresenceRule) isPresenceCheck() {}
+
+// describedNullRule is a describedRule wrapping a nullCheck rule, so it still runs for present nil values.
+type describedNullRule struct {
+	describedRule
+}
+
+func (describedNullRule) isNullCheck() {}
+
+// asDescribed returns the describedRule r is, or wraps as a presence or nullCheck rule.
+func asDescribed(r Rule) (describedRule, bool) {
+	switch d := r.(type) {
+	case describedRule:
+		return d, true
+	case describedPresenceRule:
+		return d.describedRule, true
+	case describedNullRule:
+		return d.describedRule, true
+	default:
+		return describedRule{}, false
+	}
+}
+
+// describe attaches a JSON Schema description to r, keeping r's presence behaviour.
+func describe(fn describeFunc, r Rule) Rule {
+	d := describedRule{rule: r, describe: fn}
+	if _, ok := r.(presenceRule); ok {
+		return describedPresenceRule{d}
+	}
+
+	return d
+}
+
+// withKeywords attaches fixed JSON Schema keywords, and a type when typ is not empty, to r.
+func withKeywords(typ string, keywords map[string]any, r Rule) Rule {
+	return describe(
+		func(_ *jsonSchemaBuilder, n *jsonSchemaNode) {
+			n.setType(typ)
+			for k, v := range keywords {
+				n.keywords[k] = v
+			}
+		}, r,
+	)
+}
+
+// withFormat attaches type string and a JSON Schema format to r.
+func withFormat(format string, r Rule) Rule {
+	return withKeywords("string", map[string]any{"format": format}, r)
+}
+
+// describeRequired marks the value as required by the enclosing object.
+func describeRequired(_ *jsonSchemaBuilder, n *jsonSchemaNode) {
+	n.required = true
+}
+
+// textEnum lists the JSON values matching textual parameters the way textEqual does: each parameter as a string, and
+// also as a number or boolean when it parses as one.
+func textEnum(params []string) []any {
+	values := make([]any, 0, len(params))
+	for _, p := range params {
+		values = append(values, p)
+		if n, _, err := numberParam(p); err == nil {
+			values = append(values, n)
+		} else if b, err := strconv.ParseBool(p); err == nil {
+			values = append(values, b)
+		}
+	}
+
+	return values
+}
+
+type jsonSchemaBuilder struct {
+	refs   map[*Schema]string // $ref of schemas emitted as definitions
+	active map[*Schema]bool   // schemas currently being described, to detect recursion
+	defs   map[string]*jsonSchemaNode
+}
+
+// addSchema describes a nested schema on n. A schema that is already being described is recursive and is emitted
+// once under $defs, with n referencing it.
+func (b *jsonSchemaBuilder) addSchema(n *jsonSchemaNode, s *Schema) {
+	if ref, ok := b.refs[s]; ok {
+		n.keywords["$ref"] = ref
+		return
+	}
+	if !b.active[s] {
+		b.addFields(n, s)
+		return
+	}
+
+	name := fmt.Sprintf("schema%d", len(b.defs)+1)
+	b.refs[s] = "#/$defs/" + name
+	def := newJSONSchemaNode()
+	b.defs[name] = def
+	b.addFields(def, s)
+	n.keywords["$ref"] = b.refs[s]
+}
+
+func (b *jsonSchemaBuilder) addFields(n *jsonSchemaNode, s *Schema) {
+	b.active[s] = true
+	defer delete(b.active, s)
+
+	for _, f := range s.fields {
+		node := n
+		for _, segment := range strings.Split(f.path, ".") {
+			if segment == wildcard {
+				node = node.itemsNode()
+			} else {
+				node = node.property(segment)
+			}
+		}
+		if f.label != "" {
+			node.keywords["title"] = f.label
+		}
+		b.addRules(node, f.rules)
+	}
+}
+
+func (b *jsonSchemaBuilder) addRules(n *jsonSchemaNode, rules []Rule) {
+	for _, r := range rules {
+		if d, ok := asDescribed(r); ok && d.describe != nil {
+			d.describe(b, n)
+		}
+	}
+}
+
+// alternative describes a single rule on its own node, for combinators such as Any and Not.
+func (b *jsonSchemaBuilder) alternative(r Rule) map[string]any {
+	n := newJSONSchemaNode()
+	b.addRules(n, []Rule{r})
+
+	return n.document()
+}
+
+// jsonSchemaNode accumulates the description of one value: its own keywords, whether its parent requires it, and the
+// nodes of its properties or items.
+type jsonSchemaNode struct {
+	keywords   map[string]any
+	required   bool
+	properties map[string]*jsonSchemaNode
+	items      *jsonSchemaNode
+}
+
+func newJSONSchemaNode() *jsonSchemaNode {
+	return &jsonSchemaNode{keywords: make(map[string]any)}
+}
+
+func (n *jsonSchemaNode) property(name string) *jsonSchemaNode {
+	if n.properties == nil {
+		n.properties = make(map[string]*jsonSchemaNode)
+	}
+	if _, ok := n.properties[name]; !ok {
+		n.properties[name] = newJSONSchemaNode()
+	}
+
+	return n.properties[name]
+}
+
+func (n *jsonSchemaNode) itemsNode() *jsonSchemaNode {
+	if n.items == nil {
+		n.items = newJSONSchemaNode()
+	}
+
+	return n.items
+}
+
+// setType sets the type keyword. An integer type is kept when a numeric rule later declares number.
+func (n *jsonSchemaNode) setType(typ string) {
+	if typ == "" || (typ == "number" && n.keywords["type"] == "integer") {
+		return
+	}
+	n.keywords["type"] = typ
+}
+
+func (n *jsonSchemaNode) document() map[string]any {
+	doc := make(map[string]any, len(n.keywords)+3)
+	for k, v := range n.keywords {
+		doc[k] = v
+	}
+
+	if n.properties != nil {
+		props := make(map[string]any, len(n.properties))
+		var required []string
+		for name, p := range n.properties {
+			props[name] = p.document()
+			if p.required {
+				required = append(required, name)
+			}
+		}
+		doc["properties"] = props
+		if len(required) > 0 {
+			sort.Strings(required)
+			doc["required"] = required
+		}
+		if _, ok := doc["type"]; !ok && n.items == nil {
+			doc["type"] = "object"
+		}
+	}
+
+	if n.items != nil {
+		doc["items"] = n.items.document()
+		if _, ok := doc["type"]; !ok && n.properties == nil {
+			doc["type"] = "array"
+		}
+	}
+
+	return doc
+}
@@ -0,0 +1,584 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LoadJSONSchema compiles a JSON Schema document into a Schema, so contracts received as JSON Schema are checked by the
+// same engine and reported with the same FieldError codes as schemas built in Go.
+//
+// The root must describe an object. Each property becomes a field, and nested objects and array items become Nested
+// and Each rules. Supported keywords and the codes they report:
+//   - required → "required"
+//   - type → "type" (Params: {"type": "string"}); "integer" also accepts whole floats such as 3.0
+//   - enum, const → "in"
+//   - minLength, maxLength, pattern → "min_length", "max_length", "regex"
+//   - minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf → "min", "max", "gt", "lt", "multiple_of"
+//   - minItems, maxItems, items → "min_size", "max_size", and per-element errors at "tags.0"
+//   - properties → nested errors at "address.city"
+//   - format email, uuid, uri, ipv4, ipv6, date-time → "email", "uuid", "url", "ipv4", "ipv6", "date_time_format"
+//   - allOf, anyOf, not → every rule, "any", "not"
+//
+// As in JSON Schema, the string, number, array, object, and format keywords only constrain values of their own type;
+// combine them with type to reject other types. Other formats are treated as annotations and ignored, as are title,
+// description, default, examples, and similar annotation keywords. Any other keyword ($ref, oneOf,
+// additionalProperties other than true, ...) is reported as a RuleSyntaxError naming its location, as are malformed
+// keyword values.
+//
+// JSON Schema's required only asks for the key to be present; here it maps to Required, which also rejects null and
+// the empty string. A null that is present is checked like any other value by type, enum, const, anyOf, and not, so
+// {"type": "string"} rejects it; an absent property is only checked by required.
+//
+// Example:
+//
+//	schema, err := validation.LoadJSONSchema(strings.NewReader(`{
+//		"type": "object",
+//		"required": ["email"],
+//		"properties": {
+//			"email": {"type": "string", "format": "email", "maxLength": 255},
+//			"tags": {"type": "array", "items": {"type": "string", "pattern": "^[a-z]+$"}}
+//		}
+//	}`))
+func LoadJSONSchema(r io.Reader) (*Schema, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("validation: decode JSON Schema: %w", err)
+	}
+
+	obj, ok := doc.(map[string]any)
+	if !ok {
+		return nil, jsonSchemaError("", "", errors.New("the root must be an object schema"))
+	}
+	if t, ok := obj["type"]; ok && t != "object" {
+		return nil, jsonSchemaError("", "type", errors.New("the root must be an object schema"))
+	}
+	if err := checkJSONSchemaKeywords("", obj, "type", "properties", "required"); err != nil {
+		return nil, err
+	}
+
+	return compileJSONSchemaObject("", obj)
+}
+
+// jsonSchemaAnnotations lists keywords that carry no validation and are ignored.
+var jsonSchemaAnnotations = []string{
+	"$schema", "$id", "$comment", "$defs", "definitions", "title", "description", "default", "examples", "deprecated",
+	"readOnly", "writeOnly", "contentMediaType", "contentEncoding",
+}
+
+// jsonSchemaValueKeywords lists the keywords compileJSONSchemaValue supports.
+var jsonSchemaValueKeywords = []string{
+	"type", "enum", "const", "format", "minLength", "maxLength", "pattern", "minimum", "exclusiveMinimum", "maximum",
+	"exclusiveMaximum", "multipleOf", "minItems", "maxItems", "items", "properties", "required", "allOf", "anyOf", "not",
+}
+
+var jsonSchemaFormats = map[string]Rule{
+	"email":     Email,
+	"uuid":      UUID,
+	"uri":       URL,
+	"ipv4":      IPv4,
+	"ipv6":      IPv6,
+	"date-time": DateTimeFormat(time.RFC3339),
+}
+
+var jsonSchemaTypes = []string{"string", "number", "integer", "boolean", "object", "array", "null"}
+
+// jsonSchemaError reports a malformed or unsupported keyword at a JSON Pointer location.
+func jsonSchemaError(loc, keyword string, err error) error {
+	return RuleSyntaxError{Rule: "json_schema", Err: fmt.Errorf("%s/%s: %w", loc, keyword, err)}
+}
+
+func checkJSONSchemaKeywords(loc string, obj map[string]any, supported ...string) error {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if slices.Contains(supported, k) || slices.Contains(jsonSchemaAnnotations, k) {
+			continue
+		}
+		if k == "additionalProperties" && (obj[k] == true || reflect.DeepEqual(obj[k], map[string]any{})) {
+			continue
+		}
+		return jsonSchemaError(loc, k, errors.New("unsupported keyword"))
+	}
+
+	return nil
+}
+
+// compileJSONSchemaObject compiles properties and required into a Schema with one field per property, in name order.
+func compileJSONSchemaObject(loc string, obj map[string]any) (*Schema, error) {
+	props := map[string]any{}
+	if raw, ok := obj["properties"]; ok {
+		if props, ok = raw.(map[string]any); !ok {
+			return nil, jsonSchemaError(loc, "properties", errors.New("must be an object"))
+		}
+	}
+
+	var required []string
+	if raw, ok := obj["required"]; ok {
+		list, err := jsonSchemaStrings(raw)
+		if _, isArray := raw.([]any); err != nil || !isArray {
+			return nil, jsonSchemaError(loc, "required", errors.New("must be an array of strings"))
+		}
+		required = list
+	}
+
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	for _, name := range required {
+		if _, ok := props[name]; !ok && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	s := New()
+	for _, name := range names {
+		if name == "" || strings.Contains(name, ".") || name == wildcard {
+			return nil, jsonSchemaError(loc, "properties", fmt.Errorf("property name %q cannot be used as a path", name))
+		}
+
+		var rules []Rule
+		if slices.Contains(required, name) {
+			rules = append(rules, Required)
+		}
+		if prop, ok := props[name]; ok {
+			propRules, err := compileJSONSchemaValue(loc+"/properties/"+name, prop)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, propRules...)
+		}
+		s.Field(name, rules...)
+	}
+
+	return s, nil
+}
+
+// compileJSONSchemaValue compiles the keywords describing a single value, in a fixed keyword order so errors are
+// reported deterministically.
+func compileJSONSchemaValue(loc string, schema any) ([]Rule, error) {
+	if schema == true {
+		return nil, nil
+	}
+	obj, ok := schema.(map[string]any)
+	if !ok {
+		return nil, jsonSchemaError(loc, "", errors.New("schema must be an object or true"))
+	}
+	if err := checkJSONSchemaKeywords(loc, obj, jsonSchemaValueKeywords...); err != nil {
+		return nil, err
+	}
+
+	var rules []Rule
+	for _, compile := range []func(string, map[string]any) ([]Rule, error){
+		jsonSchemaGenericRules,
+		jsonSchemaStringRules,
+		jsonSchemaNumberRules,
+		jsonSchemaArrayRules,
+		jsonSchemaObjectRules,
+		jsonSchemaCombinatorRules,
+	} {
+		compiled, err := compile(loc, obj)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, compiled...)
+	}
+
+	return rules, nil
+}
+
+// jsonSchemaGenericRules compiles type, enum, and const.
+func jsonSchemaGenericRules(loc string, obj map[string]any) ([]Rule, error) {
+	var rules []Rule
+
+	if raw, ok := obj["type"]; ok {
+		types, err := jsonSchemaStrings(raw)
+		if err != nil || len(types) == 0 {
+			return nil, jsonSchemaError(loc, "type", errors.New("must be a type name or a list of type names"))
+		}
+		for _, t := range types {
+			if !slices.Contains(jsonSchemaTypes, t) {
+				return nil, jsonSchemaError(loc, "type", fmt.Errorf("unknown type %q", t))
+			}
+		}
+		rules = append(rules, jsonTypeRule(types))
+	}
+
+	if raw, ok := obj["enum"]; ok {
+		values, ok := raw.([]any)
+		if !ok || len(values) == 0 {
+			return nil, jsonSchemaError(loc, "enum", errors.New("must be a non-empty array"))
+		}
+		rules = append(rules, jsonEnumRule(values))
+	}
+	if raw, ok := obj["const"]; ok {
+		rules = append(rules, jsonEnumRule([]any{raw}))
+	}
+
+	return rules, nil
+}
+
+// jsonSchemaStringRules compiles format, minLength, maxLength, and pattern.
+func jsonSchemaStringRules(loc string, obj map[string]any) ([]Rule, error) {
+	var rules []Rule
+
+	if raw, ok := obj["format"]; ok {
+		format, ok := raw.(string)
+		if !ok {
+			return nil, jsonSchemaError(loc, "format", errors.New("must be a string"))
+		}
+		if r, ok := jsonSchemaFormats[format]; ok {
+			rules = append(rules, forJSONType("string", r))
+		}
+	}
+
+	for _, kw := range []struct {
+		keyword string
+		rule    func(int) Rule
+	}{
+		{"minLength", MinLength},
+		{"maxLength", MaxLength},
+	} {
+		if raw, ok := obj[kw.keyword]; ok {
+			n, err := jsonSchemaCount(raw)
+			if err != nil {
+				return nil, jsonSchemaError(loc, kw.keyword, err)
+			}
+			rules = append(rules, forJSONType("string", kw.rule(n)))
+		}
+	}
+
+	if raw, ok := obj["pattern"]; ok {
+		pattern, ok := raw.(string)
+		if !ok {
+			return nil, jsonSchemaError(loc, "pattern", errors.New("must be a string"))
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, jsonSchemaError(loc, "pattern", err)
+		}
+		rules = append(rules, forJSONType("string", Regex(pattern)))
+	}
+
+	return rules, nil
+}
+
+// jsonSchemaNumberRules compiles the numeric keywords with the loose numeric rules of the textual formats, which
+// accept any numeric kind.
+func jsonSchemaNumberRules(loc string, obj map[string]any) ([]Rule, error) {
+	var rules []Rule
+
+	for _, kw := range []struct{ keyword, rule string }{
+		{"minimum", "min"},
+		{"exclusiveMinimum", "gt"},
+		{"maximum", "max"},
+		{"exclusiveMaximum", "lt"},
+		{"multipleOf", "multiple_of"},
+	} {
+		raw, ok := obj[kw.keyword]
+		if !ok {
+			continue
+		}
+		n, ok := raw.(json.Number)
+		if !ok {
+			return nil, jsonSchemaError(loc, kw.keyword, errors.New("must be a number"))
+		}
+		r, err := defaultRegistry.Rule(kw.rule, n.String())
+		if err != nil {
+			return nil, jsonSchemaError(loc, kw.keyword, err)
+		}
+		rules = append(rules, forJSONType("number", r))
+	}
+
+	return rules, nil
+}
+
+// jsonSchemaArrayRules compiles minItems, maxItems, and items.
+func jsonSchemaArrayRules(loc string, obj map[string]any) ([]Rule, error) {
+	var rules []Rule
+
+	for _, kw := range []struct {
+		keyword string
+		rule    func(int) Rule
+	}{
+		{"minItems", MinSize},
+		{"maxItems", MaxSize},
+	} {
+		if raw, ok := obj[kw.keyword]; ok {
+			n, err := jsonSchemaCount(raw)
+			if err != nil {
+				return nil, jsonSchemaError(loc, kw.keyword, err)
+			}
+			rules = append(rules, forJSONType("array", kw.rule(n)))
+		}
+	}
+
+	if raw, ok := obj["items"]; ok {
+		itemRules, err := compileJSONSchemaValue(loc+"/items", raw)
+		if err != nil {
+			return nil, err
+		}
+		if len(itemRules) > 0 {
+			rules = append(rules, Each(itemRules...))
+		}
+	}
+
+	return rules, nil
+}
+
+// jsonSchemaObjectRules compiles properties and required into a Nested rule.
+func jsonSchemaObjectRules(loc string, obj map[string]any) ([]Rule, error) {
+	_, hasProps := obj["properties"]
+	_, hasRequired := obj["required"]
+	if !hasProps && !hasRequired {
+		return nil, nil
+	}
+
+	sub, err := compileJSONSchemaObject(loc, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Rule{forJSONType("object", Nested(sub))}, nil
+}
+
+// jsonSchemaCombinatorRules compiles allOf, anyOf, and not.
+func jsonSchemaCombinatorRules(loc string, obj map[string]any) ([]Rule, error) {
+	var rules []Rule
+
+	if raw, ok := obj["allOf"]; ok {
+		alternatives, err := compileJSONSchemaList(loc, "allOf", raw)
+		if err != nil {
+			return nil, err
+		}
+		for _, alt := range alternatives {
+			rules = append(rules, alt...)
+		}
+	}
+
+	if raw, ok := obj["anyOf"]; ok {
+		alternatives, err := compileJSONSchemaList(loc, "anyOf", raw)
+		if err != nil {
+			return nil, err
+		}
+		anyOf := make([]Rule, 0, len(alternatives))
+		for _, alt := range alternatives {
+			anyOf = append(anyOf, allRules(alt))
+		}
+		rules = append(rules, checksNull(Any(anyOf...)))
+	}
+
+	if raw, ok := obj["not"]; ok {
+		notRules, err := compileJSONSchemaValue(loc+"/not", raw)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, checksNull(Not(allRules(notRules))))
+	}
+
+	return rules, nil
+}
+
+func compileJSONSchemaList(loc, keyword string, raw any) ([][]Rule, error) {
+	list, ok := raw.([]any)
+	if !ok || len(list) == 0 {
+		return nil, jsonSchemaError(loc, keyword, errors.New("must be a non-empty array"))
+	}
+
+	compiled := make([][]Rule, 0, len(list))
+	for i, schema := range list {
+		rules, err := compileJSONSchemaValue(loc+"/"+keyword+"/"+strconv.Itoa(i), schema)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, rules)
+	}
+
+	return compiled, nil
+}
+
+func jsonSchemaStrings(raw any) ([]string, error) {
+	if s, ok := raw.(string); ok {
+		return []string{s}, nil
+	}
+
+	list, ok := raw.([]any)
+	if !ok {
+		return nil, errors.New("must be a string or an array of strings")
+	}
+	strs := make([]string, 0, len(list))
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.New("must be a string or an array of strings")
+		}
+		strs = append(strs, s)
+	}
+
+	return strs, nil
+}
+
+func jsonSchemaCount(raw any) (int, error) {
+	n, ok := raw.(json.Number)
+	if !ok {
+		return 0, errors.New("must be a non-negative integer")
+	}
+	i, err := strconv.Atoi(n.String())
+	if err != nil || i < 0 {
+		return 0, errors.New("must be a non-negative integer")
+	}
+
+	return i, nil
+}
+
+// allRules combines rules into one that fails with the first failing rule, for the alternatives of Any and Not. As for
+// a field, rules that do not run for nil values are skipped for a null.
+func allRules(rules []Rule) Rule {
+	return InputRuleFunc(
+		func(value any, input *InputBag) error {
+			for _, r := range rules {
+				if value == nil && !runsForNil(r, true) {
+					continue
+				}
+				if err := applyRule(r, value, input); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+// forJSONType applies r only to values of the given JSON type, the way JSON Schema keywords ignore other types. It
+// keeps the description of r, and the Nested sub-schema it validates with.
+func forJSONType(t string, r Rule) Rule {
+	fn := InputRuleFunc(
+		func(value any, input *InputBag) error {
+			if !isJSONType(value, t) {
+				return nil
+			}
+			return applyRule(r, value, input)
+		},
+	)
+	if d, ok := r.(describedRule); ok {
+		d.rule = fn
+		return d
+	}
+
+	return fn
+}
+
+// checksNull makes a described combinator run for null values too, as the keywords it combines do.
+func checksNull(r Rule) Rule {
+	d, _ := asDescribed(r)
+	inner := d.rule
+	d.rule = nullInputRuleFunc(
+		func(value any, input *InputBag) error {
+			return applyRule(inner, value, input)
+		},
+	)
+
+	return describedNullRule{d}
+}
+
+// jsonTypeRule and jsonEnumRule check null like any other value; they are skipped only for absent fields.
+func jsonTypeRule(types []string) Rule {
+	return nullInputRuleFunc(
+		func(value any, _ *InputBag) error {
+			for _, t := range types {
+				if isJSONType(value, t) {
+					return nil
+				}
+			}
+			return typeError{Type: strings.Join(types, ", ")}
+		},
+	)
+}
+
+func jsonEnumRule(values []any) Rule {
+	return nullInputRuleFunc(
+		func(value any, _ *InputBag) error {
+			for _, v := range values {
+				if jsonEqual(value, v) {
+					return nil
+				}
+			}
+			return inError{Values: values}
+		},
+	)
+}
+
+// isJSONType reports whether a Go value has the given JSON Schema type. Integers are numbers, and so are whole floats.
+func isJSONType(value any, t string) bool {
+	if value == nil {
+		return t == "null"
+	}
+
+	rv := reflect.ValueOf(value)
+	switch t {
+	case "string":
+		return rv.Kind() == reflect.String
+	case "boolean":
+		return rv.Kind() == reflect.Bool
+	case "number":
+		_, ok := numberValue(value)
+		return ok
+	case "integer":
+		f, ok := numberValue(value)
+		return ok && f == math.Trunc(f) && !math.IsInf(f, 0)
+	case "array":
+		return rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array
+	case "object":
+		switch rv.Kind() {
+		case reflect.Map:
+			return rv.Type().Key().Kind() == reflect.String
+		case reflect.Struct:
+			return true
+		case reflect.Pointer:
+			return !rv.IsNil() && rv.Elem().Kind() == reflect.Struct
+		}
+	}
+
+	return false
+}
+
+// jsonEqual compares a Go value with a decoded JSON value. Numbers compare numerically regardless of their Go kind.
+func jsonEqual(value, v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return value == nil
+	case string:
+		rv := reflect.ValueOf(value)
+		return rv.Kind() == reflect.String && rv.String() == v
+	case bool:
+		rv := reflect.ValueOf(value)
+		return rv.Kind() == reflect.Bool && rv.Bool() == v
+	case json.Number:
+		f, ok := numberValue(value)
+		if !ok {
+			return false
+		}
+		n, err := v.Float64()
+		return err == nil && f == n
+	default:
+		return reflect.DeepEqual(value, v)
+	}
+}
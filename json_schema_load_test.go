@@ -0,0 +1,243 @@
+package validation
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testJSONSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "Order",
+  "type": "object",
+  "required": ["email", "items"],
+  "properties": {
+    "email": {"type": "string", "format": "email", "maxLength": 30},
+    "status": {"enum": ["draft", "paid"]},
+    "code": {"type": "string", "pattern": "^[A-Z]{3}$", "minLength": 3},
+    "qty": {"type": "integer", "minimum": 1, "exclusiveMaximum": 100},
+    "price": {"type": ["number", "null"], "multipleOf": 0.5},
+    "items": {
+      "type": "array",
+      "minItems": 1,
+      "items": {
+        "type": "object",
+        "required": ["sku"],
+        "properties": {"sku": {"type": "string"}, "note": {"description": "free text"}}
+      }
+    },
+    "contact": {"anyOf": [{"format": "email"}, {"type": "string", "pattern": "^\\+"}]},
+    "username": {"not": {"enum": ["root"]}},
+    "address": {"type": "object", "properties": {"city": {"type": "string", "minLength": 2}}, "additionalProperties": true}
+  }
+}`
+
+func TestLoadJSONSchema(t *testing.T) {
+	schema, err := LoadJSONSchema(strings.NewReader(testJSONSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		input map[string]any
+		want  []string
+	}{
+		{
+			"valid",
+			map[string]any{
+				"email":    "a@example.com",
+				"status":   "paid",
+				"code":     "ABC",
+				"qty":      float64(3),
+				"price":    2.5,
+				"items":    []any{map[string]any{"sku": "x"}},
+				"contact":  "+4912345",
+				"username": "ann",
+				"address":  map[string]any{"city": "Oslo", "zip": "0150"},
+			},
+			nil,
+		},
+		{
+			"invalid",
+			map[string]any{
+				"email":    "not-an-email",
+				"status":   "void",
+				"code":     "ab",
+				"qty":      1.5,
+				"price":    "cheap",
+				"items":    []any{map[string]any{"sku": 7}, map[string]any{}},
+				"contact":  "nobody",
+				"username": "root",
+				"address":  map[string]any{"city": "X"},
+			},
+			[]string{
+				"address.city:min_length",
+				"code:min_length",
+				"code:regex",
+				"contact:any",
+				"email:email",
+				"items.0.sku:type",
+				"items.1.sku:required",
+				"price:type",
+				"qty:type",
+				"status:in",
+				"username:not",
+			},
+		},
+		{
+			"missing required",
+			map[string]any{"items": []any{}},
+			[]string{"email:required", "items:min_size"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				res, err := schema.Validate(tt.input)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got := pathCodes(res)
+				if !slices.Equal(got, tt.want) {
+					t.Errorf("errors = %v\nwant %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestLoadJSONSchema_Null(t *testing.T) {
+	doc := `{
+		"type": "object",
+		"properties": {
+			"name":     {"type": "string"},
+			"status":   {"enum": ["a", "b"]},
+			"kind":     {"const": "user"},
+			"nickname": {"type": ["string", "null"]},
+			"note":     {"anyOf": [{"type": "string"}, {"type": "integer"}]},
+			"alias":    {"not": {"type": "null"}},
+			"code":     {"minLength": 2}
+		}
+	}`
+	schema, err := LoadJSONSchema(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nulls := map[string]any{
+		"name": nil, "status": nil, "kind": nil, "nickname": nil, "note": nil, "alias": nil, "code": nil,
+	}
+	res, err := schema.Validate(nulls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"alias:not", "kind:in", "name:type", "note:any", "status:in"}
+	if got := pathCodes(res); !slices.Equal(got, want) {
+		t.Errorf("null values: errors = %v\nwant %v", got, want)
+	}
+
+	res, err = schema.Validate(map[string]any{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("absent values: unexpected errors %v", pathCodes(res))
+	}
+}
+
+func TestLoadJSONSchema_KeywordsIgnoreOtherTypes(t *testing.T) {
+	doc := `{
+		"properties": {
+			"a": {"required": ["x"], "properties": {"x": {"type": "string"}}},
+			"b": {"minItems": 2, "maxItems": 3}
+		}
+	}`
+	schema, err := LoadJSONSchema(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := schema.Validate(map[string]any{"a": "str", "b": "xyzw"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("non-object and non-array values: unexpected errors %v", pathCodes(res))
+	}
+
+	res, err = schema.Validate(map[string]any{"a": map[string]any{"x": 1}, "b": []any{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"a.x:type", "b:min_size"}
+	if got := pathCodes(res); !slices.Equal(got, want) {
+		t.Errorf("errors = %v\nwant %v", got, want)
+	}
+}
+
+func TestLoadJSONSchema_TypeParams(t *testing.T) {
+	schema, err := LoadJSONSchema(strings.NewReader(`{"properties": {"n": {"type": ["integer", "null"]}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := schema.Validate(map[string]any{"n": "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs := res.For("n")
+	if len(errs) != 1 || errs[0].Params["type"] != "integer, null" {
+		t.Errorf("errors = %v, want one type error with type param", res.Errors())
+	}
+}
+
+func TestLoadJSONSchema_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		doc        string
+		wantSyntax bool
+	}{
+		{"malformed json", `{"properties": `, false},
+		{"root not an object", `[]`, true},
+		{"root type", `{"type": "array"}`, true},
+		{"unsupported keyword", `{"properties": {"a": {"oneOf": [{"type": "string"}]}}}`, true},
+		{"ref", `{"properties": {"a": {"$ref": "#/$defs/a"}}}`, true},
+		{"closed object", `{"additionalProperties": false}`, true},
+		{"unknown type", `{"properties": {"a": {"type": "text"}}}`, true},
+		{"bad count", `{"properties": {"a": {"minLength": -1}}}`, true},
+		{"bad number", `{"properties": {"a": {"minimum": "1"}}}`, true},
+		{"bad pattern", `{"properties": {"a": {"pattern": "("}}}`, true},
+		{"zero multiple", `{"properties": {"a": {"multipleOf": 0}}}`, true},
+		{"empty enum", `{"properties": {"a": {"enum": []}}}`, true},
+		{"dotted property", `{"properties": {"a.b": {}}}`, true},
+		{"bad required", `{"required": "a"}`, true},
+		{"nested error", `{"properties": {"a": {"items": {"properties": {"b": {"type": 1}}}}}}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, err := LoadJSONSchema(strings.NewReader(tt.doc))
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				var rse RuleSyntaxError
+				if errors.As(err, &rse) != tt.wantSyntax {
+					t.Errorf("errors.As(RuleSyntaxError) = %v, want %v (err: %v)", !tt.wantSyntax, tt.wantSyntax, err)
+				}
+			},
+		)
+	}
+}
+
+func TestLoadJSONSchema_ErrorLocation(t *testing.T) {
+	_, err := LoadJSONSchema(strings.NewReader(`{"properties": {"a": {"items": {"maxLength": "x"}}}}`))
+	if err == nil || !strings.Contains(err.Error(), "/properties/a/items/maxLength") {
+		t.Errorf("error = %v, want the keyword location", err)
+	}
+}
@@ -0,0 +1,194 @@
+package validation
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// assertJSONSchema compares the marshaled JSON Schema of s with the expected document.
+func assertJSONSchema(t *testing.T, s *Schema, want string) {
+	t.Helper()
+
+	raw, err := json.Marshal(s.JSONSchema())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got, expected any
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(want), &expected); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("JSONSchema() = %s\nwant %s", raw, want)
+	}
+}
+
+func TestSchemaJSONSchema(t *testing.T) {
+	address := New().
+		Field("city", Required, MinLength(2)).
+		Field("zip", Regex(`^\d{5}$`))
+
+	schema := New().
+		Field("email", Required, Email, MaxLength(255)).
+		Field("age", Integer, Between[int](18, 130)).
+		Field("score", GT[float64](0), LTE[float64](1)).
+		Field("role", In([]string{"admin", "editor"})).
+		Field("id", UUID).
+		Field("site", URL).
+		Field("ip", IPv4).
+		Field("tags", MinSize(1), Each(Slug, MaxLength(20))).
+		Field("address", Required, Nested(address)).
+		Field("profile.bio", Length(10)).
+		Field("items.*.sku", Required).
+		Field("contact", Any(Email, PhoneE164)).
+		Field("username", Not(In([]string{"root"})), RequiredIf(`exists(email)`))
+
+	assertJSONSchema(
+		t, schema, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["address", "email"],
+		"properties": {
+			"email": {"type": "string", "format": "email", "maxLength": 255},
+			"age": {"type": "integer", "minimum": 18, "maximum": 130},
+			"score": {"type": "number", "exclusiveMinimum": 0, "maximum": 1},
+			"role": {"enum": ["admin", "editor"]},
+			"id": {"type": "string", "format": "uuid"},
+			"site": {"type": "string", "format": "uri"},
+			"ip": {"type": "string", "format": "ipv4"},
+			"tags": {"type": "array", "minItems": 1, "items": {"type": "string", "maxLength": 20}},
+			"address": {
+				"type": "object",
+				"required": ["city"],
+				"properties": {
+					"city": {"type": "string", "minLength": 2},
+					"zip": {"type": "string", "pattern": "^\\d{5}$"}
+				}
+			},
+			"profile": {"type": "object", "properties": {"bio": {"type": "string", "minLength": 10, "maxLength": 10}}},
+			"items": {
+				"type": "array",
+				"items": {"type": "object", "required": ["sku"], "properties": {"sku": {}}}
+			},
+			"contact": {"anyOf": [{"type": "string", "format": "email"}, {}]},
+			"username": {"not": {"enum": ["root"]}}
+		}
+	}`,
+	)
+}
+
+func TestSchemaJSONSchema_Label(t *testing.T) {
+	schema := New().
+		Field("email", Required, Email).Label("Email address").
+		Field("name", Required)
+
+	assertJSONSchema(
+		t, schema, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["email", "name"],
+		"properties": {
+			"email": {"title": "Email address", "type": "string", "format": "email"},
+			"name": {}
+		}
+	}`,
+	)
+}
+
+func TestSchemaJSONSchema_Textual(t *testing.T) {
+	schema, err := FromMap(
+		map[string]string{
+			"qty":    "required|min:1|lt:100|multiple_of:5",
+			"status": "in:draft,1,true",
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertJSONSchema(
+		t, schema, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["qty"],
+		"properties": {
+			"qty": {"type": "number", "minimum": 1, "exclusiveMaximum": 100, "multipleOf": 5},
+			"status": {"enum": ["draft", "1", 1, "true", true]}
+		}
+	}`,
+	)
+}
+
+func TestSchemaJSONSchema_Recursive(t *testing.T) {
+	type Category struct {
+		Name     string     `json:"name" validate:"required"`
+		Children []Category `json:"children"`
+	}
+
+	schema, err := FromStruct(Category{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertJSONSchema(
+		t, schema, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {},
+			"children": {"type": "array", "items": {"$ref": "#"}}
+		}
+	}`,
+	)
+
+	tree := New().Field("name", Required)
+	node := New().Field("label", Required)
+	node.Field("children.*", Nested(node))
+	tree.Field("root", Nested(node))
+
+	assertJSONSchema(
+		t, tree, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {},
+			"root": {
+				"type": "object",
+				"required": ["label"],
+				"properties": {
+					"label": {},
+					"children": {"type": "array", "items": {"$ref": "#/$defs/schema1"}}
+				}
+			}
+		},
+		"$defs": {
+			"schema1": {
+				"type": "object",
+				"required": ["label"],
+				"properties": {
+					"label": {},
+					"children": {"type": "array", "items": {"$ref": "#/$defs/schema1"}}
+				}
+			}
+		}
+	}`,
+	)
+}
+
+func TestDescribedRule_PreservesBehaviour(t *testing.T) {
+	schema := New().Field("name", Required, MinLength(2))
+
+	res, err := schema.Validate(map[string]any{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := res.For("name"); len(errs) != 1 || errs[0].Code != "required" {
+		t.Errorf("errors = %v, want one required error", res.Errors())
+	}
+}
@@ -3,7 +3,7 @@ package validation
 // Any returns a Rule that passes when at least one of the given rules passes.
 //
 // All rules are tried in order; the first pass short-circuits. If every rule fails, basicError{"any", "any validation failed"} is returned.
-// The inner errors are not propagated.
+// The inner errors are not propagated, except a RuleSyntaxError or context error, which is returned unchanged.
 //
 // Fails if:
 //   - all supplied rules fail for the value
@@ -14,22 +14,37 @@ package validation
 //	validation.Any(validation.Email, validation.PhoneE164).Validate("+14155552671")      // pass
 //	validation.Any(validation.Email, validation.PhoneE164).Validate("notvalid")          // fail
 func Any(rules ...Rule) Rule {
-	return InputRuleFunc(
+	describeAnyOf := func(b *jsonSchemaBuilder, n *jsonSchemaNode) {
+		anyOf := make([]any, 0, len(rules))
+		for _, r := range rules {
+			anyOf = append(anyOf, b.alternative(r))
+		}
+		n.keywords["anyOf"] = anyOf
+	}
+
+	rule := InputRuleFunc(
 		func(value any, input *InputBag) error {
 			for _, r := range rules {
-				if err := applyRule(r, value, input); err == nil {
+				err := applyRule(r, value, input)
+				if err == nil {
 					return nil
 				}
+				if abortsValidation(err) {
+					return err
+				}
 			}
 
 			return basicError{"any", "any validation failed"}
 		},
 	)
+
+	return describedRule{rule: rule, describe: describeAnyOf, inner: rules}
 }
 
 // Not returns a Rule that inverts the result of the given rule.
 //
 // Passes when the inner rule fails; fails (returning basicError{"not", "not validation failed"}) when the inner rule passes.
+// A RuleSyntaxError or context error from the inner rule is returned unchanged rather than counted as a failure.
 //
 // Fails if:
 //   - the wrapped rule passes for the value
@@ -40,15 +55,27 @@ func Any(rules ...Rule) Rule {
 //	validation.Not(validation.Email).Validate("user@example.com") // fail
 //	validation.Not(validation.UUID).Validate("not-a-uuid")    // pass
 func Not(r Rule) Rule {
-	return InputRuleFunc(
+	describeNot := func(b *jsonSchemaBuilder, n *jsonSchemaNode) {
+		// An undescribed rule would become {"not": {}}, which rejects everything.
+		if inner := b.alternative(r); len(inner) > 0 {
+			n.keywords["not"] = inner
+		}
+	}
+
+	rule := InputRuleFunc(
 		func(value any, input *InputBag) error {
 			if err := applyRule(r, value, input); err != nil {
+				if abortsValidation(err) {
+					return err
+				}
 				return nil
 			}
 
 			return basicError{"not", "not validation failed"}
 		},
 	)
+
+	return describedRule{rule: rule, describe: describeNot, inner: []Rule{r}}
 }
 
 // Unless returns an InputRule that applies the given rules only when the condition evaluates to false.
@@ -57,33 +84,15 @@ func Not(r Rule) Rule {
 // The condition language is identical to RequiredIf (comparisons, &&, ||, !, exists(), len()).
 // Returns RuleSyntaxError for a malformed condition.
 //
-// The errors from the inner rules are propagated directly (unlike Any/Not/Each which return sentinels).
+// The errors from the inner rules are propagated directly (unlike Any/Not which return sentinels). As with When, a
+// presence rule among the rules makes Unless run for an absent field too.
 //
 // Examples:
 //
 //	validation.Unless(`status == "approved"`, validation.MinLength(10))
 //	validation.Unless(`exists(override)`, validation.Required)
 func Unless(condition string, rules ...Rule) InputRule {
-	return InputRuleFunc(
-		func(value any, input *InputBag) error {
-			ok, err := evalCondition(condition, input)
-			if err != nil {
-				return RuleSyntaxError{Rule: "Unless", Err: err}
-			}
-
-			if ok {
-				return nil
-			}
-
-			for _, r := range rules {
-				if err := applyRule(r, value, input); err != nil {
-					return err
-				}
-			}
-
-			return nil
-		},
-	)
+	return conditional("Unless", condition, false, rules)
 }
 
 // When returns an InputRule that applies the given rules only when the condition evaluates to true.
@@ -91,38 +100,62 @@ func Unless(condition string, rules ...Rule) InputRule {
 // The condition language is identical to RequiredIf (comparisons, &&, ||, !, exists(), len()).
 // Returns RuleSyntaxError for a malformed condition.
 //
-// The errors from the inner rules are propagated directly (unlike Any/Not/Each which return sentinels).
+// The errors from the inner rules are propagated directly (unlike Any/Not which return sentinels). With a presence
+// rule such as Required among the rules, When also runs for an absent field, so the field is required exactly when
+// the condition holds; the other rules still skip absent and nil values.
 //
 // Examples:
 //
+//	validation.When(`contact == "phone"`, validation.Required, validation.PhoneE164)
 //	validation.When(`plan == "paid"`, validation.Regex(`^[A-Z]{2}\d{9}$`), validation.MaxLength(12))
 //	validation.When(`country == "US"`, validation.Regex(`^\d{10}$`))
 func When(condition string, rules ...Rule) InputRule {
-	return InputRuleFunc(
-		func(value any, input *InputBag) error {
-			ok, err := evalCondition(condition, input)
-			if err != nil {
-				return RuleSyntaxError{Rule: "When", Err: err}
-			}
+	return conditional("When", condition, true, rules)
+}
 
-			if !ok {
-				return nil
-			}
+// conditional builds When and Unless, which run rules when the condition evaluates to want.
+//
+// Like the rules of a field, the rules are skipped for a nil value unless they are presence rules. The result is a
+// presence rule itself when any of the rules is, so When(cond, Required) also fails for an absent field.
+func conditional(name, condition string, want bool, rules []Rule) InputRule {
+	fn := func(value any, input *InputBag) error {
+		ok, err := evalCondition(condition, input)
+		if err != nil {
+			return RuleSyntaxError{Rule: name, Err: err}
+		}
 
-			for _, r := range rules {
-				if err := applyRule(r, value, input); err != nil {
-					return err
-				}
+		if ok != want {
+			return nil
+		}
+
+		for _, r := range rules {
+			if _, presence := r.(presenceRule); value == nil && !presence {
+				continue
+			}
+			if err := applyRule(r, value, input); err != nil {
+				return err
 			}
+		}
 
-			return nil
-		},
-	)
+		return nil
+	}
+
+	for _, r := range rules {
+		if _, ok := r.(presenceRule); ok {
+			return describedPresenceRule{describedRule{rule: presenceInputRuleFunc(fn), inner: rules}}
+		}
+	}
+
+	return describedRule{rule: InputRuleFunc(fn), inner: rules}
 }
 
-// applyRule dispatches a Rule, routing cross-field rules through ValidateWithInput when an InputBag is available.
-// All combinators must call this instead of r.Validate directly so that wrapped InputRules receive the full input.
+// applyRule dispatches a Rule, routing context-aware rules through ValidateContext with the context of the InputBag,
+// and cross-field rules through ValidateWithInput when an InputBag is available. All combinators must call this
+// instead of r.Validate directly so that wrapped ContextRules and InputRules receive the context and the full input.
 func applyRule(r Rule, value any, input *InputBag) error {
+	if cr, ok := r.(ContextRule); ok {
+		return cr.ValidateContext(input.Context(), value, input)
+	}
 	if ir, ok := r.(InputRule); ok && input != nil {
 		return ir.ValidateWithInput(value, input)
 	}
@@ -1,7 +1,9 @@
 package validation
 
 import (
+	"context"
 	"errors"
+	"slices"
 	"testing"
 )
 
@@ -130,6 +132,30 @@ func TestAny_WithInputRule(t *testing.T) {
 	)
 }
 
+func TestAnyNot_AbortingErrors(t *testing.T) {
+	canceled := RuleFunc(func(any) error { return context.Canceled })
+	syntax := RuleFunc(func(any) error { return RuleSyntaxError{Rule: "x", Err: errors.New("bad")} })
+
+	for name, r := range map[string]Rule{
+		"Not canceled":   Not(canceled),
+		"Any canceled":   Any(Email, canceled, UUID),
+		"Not syntax":     Not(syntax),
+		"Any syntax":     Any(syntax, Email),
+		"Loose(Not)":     Loose(Not(canceled)),
+		"Each(Any(Not))": Each(Any(Not(canceled))),
+		"When(Not)":      When("exists(a)", Not(canceled)),
+	} {
+		_, err := New().Field("a", r).Validate(map[string]any{"a": []any{"x"}})
+		if !abortsValidation(err) {
+			t.Errorf("%s: Validate error = %v, want the inner error returned", name, err)
+		}
+	}
+
+	if err := Any(canceled, Email).Validate("a@b.co"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Any with a canceled first rule error = %v, want context.Canceled", err)
+	}
+}
+
 func TestWhen(t *testing.T) {
 	schema := New().
 		Field("plan", Required).
@@ -163,6 +189,32 @@ func TestWhen(t *testing.T) {
 	)
 }
 
+func TestWhen_PresenceRules(t *testing.T) {
+	schema := New().
+		Field("phone", When(`contact == "phone"`, Required, PhoneE164)).
+		Field("nickname", Unless(`exists(name)`, Required))
+
+	tests := []struct {
+		name  string
+		input map[string]any
+		want  []string
+	}{
+		{"absent and required", map[string]any{"contact": "phone"}, []string{"phone:required", "nickname:required"}},
+		{"nil and required", map[string]any{"contact": "phone", "phone": nil, "name": "Al"}, []string{"phone:required"}},
+		{"absent, condition false", map[string]any{"contact": "email", "name": "Al"}, nil},
+		{"present and invalid", map[string]any{"contact": "phone", "phone": "x", "name": "Al"}, []string{"phone:phone_e164"}},
+	}
+	for _, tt := range tests {
+		res, err := schema.Validate(tt.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := pathCodes(res); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: errors = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
 func TestWhen_InvalidCondition(t *testing.T) {
 	schema := New().
 		Field("x", When(`!!!invalid`, MinLength(3)))
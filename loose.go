@@ -0,0 +1,179 @@
+package validation
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// looseRule is the textual "loose" rule. It never fails; Schema.Field removes it and wraps the field's other rules
+// with Loose.
+type looseRule struct{}
+
+func (looseRule) Validate(any) error { return nil }
+
+// Loose returns a Rule that runs r in loose mode, in which the numeric rules convert the value instead of requiring
+// its exact Go type. Any number, whatever its kind, a json.Number, or a string holding a decimal number converts to
+// the rule's type when it fits exactly, so Between[int] accepts the float64 that encoding/json decodes and named types
+// such as `type Age int`:
+//
+//	validation.Between[int](1, 100).Validate(42.0)                   // fail — float64 is not int
+//	validation.Loose(validation.Between[int](1, 100)).Validate(42.0) // pass
+//	validation.Loose(validation.Between[int](1, 100)).Validate("42") // pass
+//
+// A conversion that would lose information fails the rule as a wrong type would: fractions into integer types
+// (42.5), negative numbers into unsigned types, values out of the type's range, and strings that are not numbers or
+// are NaN or infinite. Loose also applies inside Any, Not, Each, When, and Nested, whose sub-schema is validated
+// loosely as a whole.
+//
+// Use Schema.Loose to validate every field loosely, or the "loose" rule in a textual schema for a single field.
+// Loose keeps r's presence and null behaviour and its JSON Schema description.
+func Loose(r Rule) Rule {
+	switch d := r.(type) {
+	case describedPresenceRule:
+		d.rule = Loose(d.rule)
+		return d
+	case describedNullRule:
+		d.rule = Loose(d.rule)
+		return d
+	case describedRule:
+		d.rule = Loose(d.rule)
+		return d
+	}
+
+	fn := func(value any, input *InputBag) error {
+		return applyRule(r, value, input.loosened())
+	}
+	switch r.(type) {
+	case presenceRule:
+		return describedPresenceRule{describedRule{rule: presenceInputRuleFunc(fn), inner: []Rule{r}}}
+	case nullCheck:
+		return describedNullRule{describedRule{rule: nullInputRuleFunc(fn), inner: []Rule{r}}}
+	}
+
+	return describedRule{rule: InputRuleFunc(fn), inner: []Rule{r}}
+}
+
+// Loose makes the numeric rules of every field convert values as described at Loose, including rules in Nested
+// sub-schemas. It returns the receiver to support chaining.
+//
+// Example:
+//
+//	schema := validation.New().Loose().
+//		Field("age", validation.Required, validation.Between[int](18, 130))
+//
+//	res, err := schema.Validate(decodedJSON) // "age": 42.0 passes
+func (s *Schema) Loose() *Schema {
+	s.loose = true
+
+	return s
+}
+
+// numberAs returns value as a T: by type assertion, or in loose mode by coerceNumber.
+func numberAs[T number](value any, input *InputBag) (T, bool) {
+	if input.Loose() {
+		return coerceNumber[T](value)
+	}
+
+	v, ok := value.(T)
+
+	return v, ok
+}
+
+// floatValue returns value as a float64 for the float-based rules: the built-in numeric types with condToFloat, or in
+// loose mode anything coerceNumber converts.
+func floatValue(value any, input *InputBag) (float64, bool) {
+	if input.Loose() {
+		return coerceNumber[float64](value)
+	}
+
+	return condToFloat(value)
+}
+
+// coerceNumber converts a number of any kind, or a string holding a decimal number, to T when T represents it
+// exactly. Integer types reject fractions, negative values for unsigned types, and values out of range; float types
+// reject values out of range and round others to the nearest representable value.
+func coerceNumber[T number](value any) (T, bool) {
+	if v, ok := value.(T); ok {
+		return v, true
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return numberFromInt[T](rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return numberFromUint[T](rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return numberFromFloat[T](rv.Float())
+	case reflect.String: // json.Number and named string types too
+		return parseNumber[T](rv.String())
+	default:
+		return 0, false
+	}
+}
+
+// parseNumber converts a decimal string to T, parsing it as an integer first so large integers keep their precision.
+func parseNumber[T number](s string) (T, bool) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return numberFromInt[T](n)
+	}
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return numberFromUint[T](n)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+
+	return numberFromFloat[T](f)
+}
+
+func numberFromInt[T number](n int64) (T, bool) {
+	v := T(n)
+	if isFloatType[T]() {
+		return v, true
+	}
+
+	return v, int64(v) == n && (v < 0) == (n < 0)
+}
+
+func numberFromUint[T number](n uint64) (T, bool) {
+	v := T(n)
+	if isFloatType[T]() {
+		return v, true
+	}
+
+	return v, uint64(v) == n && v >= 0
+}
+
+func numberFromFloat[T number](f float64) (T, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	if isFloatType[T]() {
+		var zero T
+		return T(f), !reflect.ValueOf(zero).OverflowFloat(f)
+	}
+
+	if f != math.Trunc(f) {
+		return 0, false
+	}
+	// Converting an out-of-range float to an integer type is implementation-defined, so check the range first.
+	if f >= -(1<<63) && f < 1<<63 {
+		return numberFromInt[T](int64(f))
+	}
+	if f >= 0 && f < 1<<64 {
+		return numberFromUint[T](uint64(f))
+	}
+
+	return 0, false
+}
+
+// isFloatType reports whether T is a floating-point type.
+func isFloatType[T number]() bool {
+	var zero T
+	k := reflect.TypeOf(zero).Kind()
+
+	return k == reflect.Float32 || k == reflect.Float64
+}
@@ -0,0 +1,186 @@
+package validation
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCoerceNumber(t *testing.T) {
+	type age int
+	type amount string
+
+	tests := []struct {
+		name  string
+		value any
+		want  int8
+		ok    bool
+	}{
+		{"int8", int8(5), 5, true},
+		{"int", 42, 42, true},
+		{"named int", age(42), 42, true},
+		{"uint64", uint64(127), 127, true},
+		{"float64 integral", 42.0, 42, true},
+		{"float64 negative", -128.0, -128, true},
+		{"float64 fraction", 42.5, 0, false},
+		{"float64 overflow", 128.0, 0, false},
+		{"float64 huge", 1e300, 0, false},
+		{"NaN", math.NaN(), 0, false},
+		{"int overflow", 300, 0, false},
+		{"uint overflow", uint64(math.MaxUint64), 0, false},
+		{"string", "42", 42, true},
+		{"string float", "4.0e1", 40, true},
+		{"string fraction", "4.5", 0, false},
+		{"named string", amount("-7"), -7, true},
+		{"json.Number", json.Number("12"), 12, true},
+		{"non-numeric string", "abc", 0, false},
+		{"empty string", "", 0, false},
+		{"bool", true, 0, false},
+		{"nil", nil, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := coerceNumber[int8](tt.value)
+		if got != tt.want && tt.ok || ok != tt.ok {
+			t.Errorf("%s: coerceNumber[int8](%v) = %v, %v; want %v, %v", tt.name, tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	if _, ok := coerceNumber[uint](-1.0); ok {
+		t.Error("coerceNumber[uint](-1.0) succeeded, want failure")
+	}
+	if _, ok := coerceNumber[uint64]("-1"); ok {
+		t.Error(`coerceNumber[uint64]("-1") succeeded, want failure`)
+	}
+	if v, ok := coerceNumber[uint64]("18446744073709551615"); !ok || v != math.MaxUint64 {
+		t.Errorf(`coerceNumber[uint64]("18446744073709551615") = %v, %v`, v, ok)
+	}
+	if v, ok := coerceNumber[float64]("0.25"); !ok || v != 0.25 {
+		t.Errorf(`coerceNumber[float64]("0.25") = %v, %v`, v, ok)
+	}
+	if _, ok := coerceNumber[float32](1e300); ok {
+		t.Error("coerceNumber[float32](1e300) succeeded, want failure")
+	}
+	if _, ok := coerceNumber[float64]("Inf"); ok {
+		t.Error(`coerceNumber[float64]("Inf") succeeded, want failure`)
+	}
+}
+
+func TestLoose(t *testing.T) {
+	type age int
+
+	tests := []struct {
+		name    string
+		rule    Rule
+		value   any
+		wantErr bool
+	}{
+		{"between float64", Between[int](1, 100), 42.0, false},
+		{"between named", Between[int](1, 100), age(42), false},
+		{"between string", Between[int](1, 100), "42", false},
+		{"between json.Number", Between[int](1, 100), json.Number("42"), false},
+		{"between out of range", Between[int](1, 100), 420.0, true},
+		{"between fraction", Between[int](1, 100), 42.5, true},
+		{"between text", Between[int](1, 100), "forty", true},
+		{"min", Min[uint8](18), 18.0, false},
+		{"min negative for unsigned", Min[uint8](0), -1.0, true},
+		{"max", Max[int64](10), "11", true},
+		{"gt", GT[int](0), json.Number("1"), false},
+		{"positive string", Positive, "3.5", false},
+		{"port", Port, 8080.0, false},
+		{"port fraction", Port, 80.5, true},
+		{"integer float64", Integer, 3.0, false},
+		{"integer fraction", Integer, 3.5, true},
+		{"integer string", Integer, "7", false},
+		{"numeric named", Numeric, age(1), false},
+		{"numeric json.Number", Numeric, json.Number("1.5"), false},
+		{"each", Each(Between[int](1, 3)), []any{1.0, 2.0, 3.0}, false},
+		{"any", Any(Between[int](1, 3), Between[int](10, 20)), 15.0, false},
+		{"not", Not(Between[int](1, 3)), 2.0, true},
+	}
+	for _, tt := range tests {
+		err := Loose(tt.rule).Validate(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Loose(rule).Validate(%v) error = %v, wantErr %v", tt.name, tt.value, err, tt.wantErr)
+		}
+	}
+
+	if Between[int](1, 100).Validate(42.0) == nil {
+		t.Error("Between[int] without Loose accepted a float64")
+	}
+}
+
+func TestSchemaLoose(t *testing.T) {
+	var input map[string]any
+	body := `{"age": 42, "score": 7.5, "address": {"zip": 12345}, "tags": [1, 2]}`
+	if err := json.NewDecoder(strings.NewReader(body)).Decode(&input); err != nil {
+		t.Fatal(err)
+	}
+
+	address := New().Field("zip", Between[int](10000, 99999))
+	schema := func() *Schema {
+		return New().
+			Field("age", Required, Between[int](18, 130)).
+			Field("score", Between[int](0, 10)).
+			Field("address", Nested(address)).
+			Field("tags.*", Min[uint](1))
+	}
+
+	res, err := schema().Validate(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(res.Errors()); got != 5 {
+		t.Errorf("strict schema: got %d errors, want 5: %v", got, res.Errors())
+	}
+
+	res, err = schema().Loose().Validate(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"score"} // 7.5 is not an int
+	if got := resultPaths(res); !slices.Equal(got, want) {
+		t.Errorf("loose schema: error paths = %v, want %v", got, want)
+	}
+
+	res, err = New().Field("address", Loose(Nested(address))).Validate(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("Loose(Nested): unexpected errors %v", res.Errors())
+	}
+}
+
+func TestParseRules_Loose(t *testing.T) {
+	rules, err := ParseRules("required|loose|between:1,100")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := New().Field("age", rules...).Validate(map[string]any{"age": "42"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("unexpected errors %v", res.Errors())
+	}
+
+	res, err = New().Field("age", rules...).Validate(map[string]any{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resultPaths(res); !slices.Equal(got, []string{"age"}) {
+		t.Errorf("Required under loose: error paths = %v, want [age]", got)
+	}
+}
+
+func resultPaths(res *Result) []string {
+	var paths []string
+	for _, e := range res.Errors() {
+		paths = append(paths, e.Path)
+	}
+
+	return paths
+}
@@ -0,0 +1,225 @@
+package validation
+
+// englishMessages are the bundled "en" templates, keyed by error code.
+var englishMessages = map[string]string{
+	// General
+	"required":             "is required",
+	"required_if":          "is required",
+	"required_unless":      "is required",
+	"required_with":        "is required",
+	"required_with_all":    "is required",
+	"required_without":     "is required",
+	"required_without_all": "is required",
+	"not_empty":            "must not be empty",
+
+	// String
+	"alpha":                "must contain only letters",
+	"alpha_dash":           "must contain only letters, digits, dashes, and underscores",
+	"alpha_num":            "must contain only letters and digits",
+	"alpha_space":          "must contain only letters and spaces",
+	"ascii":                "must contain only ASCII characters",
+	"base64":               "must be valid base64",
+	"contains":             "must contain {substring}",
+	"credit_card":          "must be a valid credit card number",
+	"email":                "must be a valid email address",
+	"email_mx":             "must be an email address whose domain accepts mail",
+	"email_mx_timeout":     "could not be verified in time",
+	"email_mx_unavailable": "could not be verified",
+	"ends_with":            "must end with {suffix}",
+	"hex_color":            "must be a hex color",
+	"json":                 "must be valid JSON",
+	"jwt":                  "must be a valid JWT",
+	"length":               "must be exactly {length} characters",
+	"lowercase":            "must be lowercase",
+	"max_length":           "must be at most {length} characters",
+	"min_length":           "must be at least {length} characters",
+	"not_regex":            "must not match {pattern}",
+	"phone_e164":           "must be a phone number in E.164 format",
+	"regex":                "must match {pattern}",
+	"semver":               "must be a semantic version",
+	"slug":                 "must be a valid slug",
+	"starts_with":          "must start with {prefix}",
+	"uppercase":            "must be uppercase",
+	"uuid":                 "must be a valid UUID",
+
+	// Number
+	"between":      "must be between {min} and {max}",
+	"gt":           "must be greater than {value}",
+	"gte":          "must be greater than or equal to {value}",
+	"integer":      "must be an integer",
+	"latitude":     "must be a latitude between -90 and 90",
+	"longitude":    "must be a longitude between -180 and 180",
+	"lt":           "must be less than {value}",
+	"lte":          "must be less than or equal to {value}",
+	"max":          "must be at most {value}",
+	"min":          "must be at least {value}",
+	"multiple_of":  "must be a multiple of {value}",
+	"negative":     "must be negative",
+	"non_negative": "must not be negative",
+	"numeric":      "must be numeric",
+	"port":         "must be a valid port number",
+	"positive":     "must be positive",
+
+	// Digit
+	"digits":         "must have exactly {digits} digits",
+	"digits_between": "must have between {min} and {max} digits",
+	"max_digits":     "must have at most {digits} digits",
+	"min_digits":     "must have at least {digits} digits",
+
+	// DateTime
+	"after":             "must be after {time}",
+	"after_field":       "must be after {field}",
+	"after_or_equal":    "must be at or after {time}",
+	"before":            "must be before {time}",
+	"before_field":      "must be before {field}",
+	"before_or_equal":   "must be at or before {time}",
+	"date_time":         "must be a valid date and time",
+	"date_time_between": "must be between {min} and {max}",
+	"date_time_format":  "must match the format {format}",
+	"timezone":          "must be a valid time zone",
+
+	// Network
+	"cidr":        "must be a valid CIDR block",
+	"ip":          "must be a valid IP address",
+	"ipv4":        "must be a valid IPv4 address",
+	"ipv6":        "must be a valid IPv6 address",
+	"mac_address": "must be a valid MAC address",
+	"url":         "must be a valid URL",
+
+	// Collection
+	"distinct": "must not contain duplicates",
+	"max_size": "must have at most {size} items",
+	"min_size": "must have at least {size} items",
+	"size":     "must have exactly {size} items",
+
+	// File
+	"file_type":     "must be a file of type {types}",
+	"max_file_size": "must be a file of at most {size} bytes",
+
+	// Generic and comparison
+	"in":        "must be one of {values}",
+	"not_in":    "must not be one of {values}",
+	"neq":       "must not be {value}",
+	"same_as":   "must match {field}",
+	"different": "must differ from {field}",
+	"type":      "must be of type {type}",
+
+	// Schema
+	"unknown_field": "is not allowed",
+
+	// Logical
+	"any": "is invalid",
+	"not": "is invalid",
+}
+
+// persianMessages are the bundled "fa" templates, keyed by error code.
+var persianMessages = map[string]string{
+	// General
+	"required":             "الزامی است",
+	"required_if":          "الزامی است",
+	"required_unless":      "الزامی است",
+	"required_with":        "الزامی است",
+	"required_with_all":    "الزامی است",
+	"required_without":     "الزامی است",
+	"required_without_all": "الزامی است",
+	"not_empty":            "نباید خالی باشد",
+
+	// String
+	"alpha":                "فقط باید شامل حروف باشد",
+	"alpha_dash":           "فقط باید شامل حروف، ارقام، خط تیره و زیرخط باشد",
+	"alpha_num":            "فقط باید شامل حروف و ارقام باشد",
+	"alpha_space":          "فقط باید شامل حروف و فاصله باشد",
+	"ascii":                "فقط باید شامل نویسه‌های ASCII باشد",
+	"base64":               "باید یک مقدار base64 معتبر باشد",
+	"contains":             "باید شامل {substring} باشد",
+	"credit_card":          "باید یک شماره کارت اعتباری معتبر باشد",
+	"email":                "باید یک نشانی ایمیل معتبر باشد",
+	"email_mx":             "باید نشانی ایمیلی باشد که دامنه‌ی آن ایمیل دریافت می‌کند",
+	"email_mx_timeout":     "به موقع بررسی نشد",
+	"email_mx_unavailable": "قابل بررسی نبود",
+	"ends_with":            "باید با {suffix} پایان یابد",
+	"hex_color":            "باید یک رنگ هگزادسیمال باشد",
+	"json":                 "باید یک JSON معتبر باشد",
+	"jwt":                  "باید یک JWT معتبر باشد",
+	"length":               "باید دقیقاً {length} نویسه باشد",
+	"lowercase":            "باید با حروف کوچک باشد",
+	"max_length":           "حداکثر باید {length} نویسه باشد",
+	"min_length":           "حداقل باید {length} نویسه باشد",
+	"not_regex":            "نباید با الگوی {pattern} مطابقت داشته باشد",
+	"phone_e164":           "باید یک شماره تلفن در قالب E.164 باشد",
+	"regex":                "باید با الگوی {pattern} مطابقت داشته باشد",
+	"semver":               "باید یک نسخه‌ی معنایی معتبر باشد",
+	"slug":                 "باید یک اسلاگ معتبر باشد",
+	"starts_with":          "باید با {prefix} شروع شود",
+	"uppercase":            "باید با حروف بزرگ باشد",
+	"uuid":                 "باید یک UUID معتبر باشد",
+
+	// Number
+	"between":      "باید بین {min} و {max} باشد",
+	"gt":           "باید بزرگ‌تر از {value} باشد",
+	"gte":          "باید بزرگ‌تر یا مساوی {value} باشد",
+	"integer":      "باید یک عدد صحیح باشد",
+	"latitude":     "باید یک عرض جغرافیایی بین -90 و 90 باشد",
+	"longitude":    "باید یک طول جغرافیایی بین -180 و 180 باشد",
+	"lt":           "باید کوچک‌تر از {value} باشد",
+	"lte":          "باید کوچک‌تر یا مساوی {value} باشد",
+	"max":          "حداکثر باید {value} باشد",
+	"min":          "حداقل باید {value} باشد",
+	"multiple_of":  "باید مضربی از {value} باشد",
+	"negative":     "باید منفی باشد",
+	"non_negative": "نباید منفی باشد",
+	"numeric":      "باید عددی باشد",
+	"port":         "باید یک شماره پورت معتبر باشد",
+	"positive":     "باید مثبت باشد",
+
+	// Digit
+	"digits":         "باید دقیقاً {digits} رقم داشته باشد",
+	"digits_between": "باید بین {min} تا {max} رقم داشته باشد",
+	"max_digits":     "حداکثر باید {digits} رقم داشته باشد",
+	"min_digits":     "حداقل باید {digits} رقم داشته باشد",
+
+	// DateTime
+	"after":             "باید بعد از {time} باشد",
+	"after_field":       "باید بعد از {field} باشد",
+	"after_or_equal":    "باید {time} یا بعد از آن باشد",
+	"before":            "باید قبل از {time} باشد",
+	"before_field":      "باید قبل از {field} باشد",
+	"before_or_equal":   "باید {time} یا قبل از آن باشد",
+	"date_time":         "باید یک تاریخ و زمان معتبر باشد",
+	"date_time_between": "باید بین {min} و {max} باشد",
+	"date_time_format":  "باید با قالب {format} مطابقت داشته باشد",
+	"timezone":          "باید یک منطقه‌ی زمانی معتبر باشد",
+
+	// Network
+	"cidr":        "باید یک بلوک CIDR معتبر باشد",
+	"ip":          "باید یک نشانی IP معتبر باشد",
+	"ipv4":        "باید یک نشانی IPv4 معتبر باشد",
+	"ipv6":        "باید یک نشانی IPv6 معتبر باشد",
+	"mac_address": "باید یک نشانی MAC معتبر باشد",
+	"url":         "باید یک URL معتبر باشد",
+
+	// Collection
+	"distinct": "نباید مقدار تکراری داشته باشد",
+	"max_size": "حداکثر باید {size} مورد داشته باشد",
+	"min_size": "حداقل باید {size} مورد داشته باشد",
+	"size":     "باید دقیقاً {size} مورد داشته باشد",
+
+	// File
+	"file_type":     "باید فایلی از نوع {types} باشد",
+	"max_file_size": "باید فایلی با حجم حداکثر {size} بایت باشد",
+
+	// Generic and comparison
+	"in":        "باید یکی از این مقادیر باشد: {values}",
+	"not_in":    "نباید یکی از این مقادیر باشد: {values}",
+	"neq":       "نباید برابر با {value} باشد",
+	"same_as":   "باید با {field} یکسان باشد",
+	"different": "باید با {field} متفاوت باشد",
+	"type":      "باید از نوع {type} باشد",
+
+	// Schema
+	"unknown_field": "مجاز نیست",
+
+	// Logical
+	"any": "نامعتبر است",
+	"not": "نامعتبر است",
+}
@@ -0,0 +1,53 @@
+package validation
+
+// Nested returns a Rule that validates the value against a whole sub-schema, so one Schema per domain type can be
+// reused wherever that type is embedded.
+//
+// The sub-schema treats the value as its own input: its paths, and the paths referenced by its cross-field rules, are
+// relative to the nested value. Every FieldError it produces is reported with the parent path prefixed, e.g. an error
+// at "city" in the sub-schema surfaces as "address.city". Like other non-presence rules, Nested is skipped for a nil
+// value; combine it with Required when the nested object is mandatory.
+//
+// The sub-schema runs with the context of the parent validation, partially when the parent runs through
+// Schema.ValidatePartial, and loosely when the parent or the rule is in loose mode (see Loose). A RuleSyntaxError or
+// context error from the sub-schema is returned unchanged.
+//
+// Fails if:
+//   - the sub-schema reports at least one error for the value
+//
+// Examples:
+//
+//	address := validation.New().
+//		Field("street", validation.Required).
+//		Field("city", validation.Required)
+//
+//	schema := validation.New().
+//		Field("billing", validation.Required, validation.Nested(address)).
+//		Field("shipping", validation.Nested(address))
+func Nested(schema *Schema) Rule {
+	describeSchema := func(b *jsonSchemaBuilder, n *jsonSchemaNode) {
+		b.addSchema(n, schema)
+	}
+
+	rule := InputRuleFunc(
+		func(value any, input *InputBag) error {
+			opts := validateOptions{
+				partial:   input.Partial(),
+				sanitized: input != nil && input.sanitized[schema],
+				loose:     input.Loose(),
+			}
+			res, err := schema.validate(input.Context(), value, opts)
+			if err != nil {
+				return err
+			}
+
+			if res.HasErrors() {
+				return nestedError{code: "nested", message: "nested validation failed", errors: res.Errors()}
+			}
+
+			return nil
+		},
+	)
+
+	return describedRule{rule: rule, describe: describeSchema, schema: schema}
+}
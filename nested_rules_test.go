@@ -0,0 +1,111 @@
+package validation
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNested(t *testing.T) {
+	address := New().
+		Field("street", Required).
+		Field("city", Required, MinLength(2))
+
+	schema := New().
+		Field("billing", Required, Nested(address)).
+		Field("shipping", Nested(address))
+
+	tests := []struct {
+		name  string
+		input map[string]any
+		want  []string
+	}{
+		{
+			"valid",
+			map[string]any{"billing": map[string]any{"street": "Main St", "city": "Oslo"}},
+			nil,
+		},
+		{
+			"nested errors are prefixed",
+			map[string]any{
+				"billing":  map[string]any{"city": "X"},
+				"shipping": map[string]any{"street": "Side St"},
+			},
+			[]string{"billing.street:required", "billing.city:min_length", "shipping.city:required"},
+		},
+		{
+			"absent optional nested value is skipped",
+			map[string]any{},
+			[]string{"billing:required"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				res, err := schema.Validate(tt.input)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got := pathCodes(res)
+				if !slices.Equal(got, tt.want) {
+					t.Errorf("errors = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestNested_Standalone(t *testing.T) {
+	rule := Nested(New().Field("name", Required))
+
+	if err := rule.Validate(map[string]any{"name": "x"}); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	err := rule.Validate(map[string]any{})
+	if errorCode(err) != "nested" {
+		t.Errorf("wrong error type: %v", err)
+	}
+}
+
+func TestNested_RelativeCrossFieldRules(t *testing.T) {
+	passwords := New().Field("confirm", SameAs("password"))
+	schema := New().Field("auth", Nested(passwords))
+
+	res, err := schema.Validate(
+		map[string]any{
+			"password": "outer",
+			"auth":     map[string]any{"password": "inner", "confirm": "inner"},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("expected cross-field paths to resolve inside the nested value, got %v", res.Errors())
+	}
+}
+
+func TestNested_Wildcard(t *testing.T) {
+	item := New().Field("sku", Required)
+	schema := New().Field("items.*", Nested(item))
+
+	res, err := schema.Validate(map[string]any{"items": []any{map[string]any{"sku": "a"}, map[string]any{}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.For("items.1.sku")) != 1 {
+		t.Errorf("expected error at items.1.sku, got %v", res.Errors())
+	}
+}
+
+func TestNested_RuleSyntaxError(t *testing.T) {
+	schema := New().Field("child", Nested(New().Field("name", Regex("["))))
+
+	_, err := schema.Validate(map[string]any{"child": map[string]any{"name": "x"}})
+	var rse RuleSyntaxError
+	if !errors.As(err, &rse) {
+		t.Errorf("expected RuleSyntaxError, got %v", err)
+	}
+}
@@ -20,7 +20,7 @@ import (
 //	validation.CIDR.Validate("not-cidr")        // fail
 var CIDR Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return basicError{"cidr", "cidr validation failed"}
 		}
@@ -47,7 +47,7 @@ var CIDR Rule = RuleFunc(
 //	validation.IP.Validate("not-an-ip")    // fail
 var IP Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || net.ParseIP(str) == nil {
 			return basicError{"ip", "ip validation failed"}
 		}
@@ -67,9 +67,9 @@ var IP Rule = RuleFunc(
 //	validation.IPv4.Validate("192.168.1.1") // pass
 //	validation.IPv4.Validate("::1")         // fail — IPv6
 //	validation.IPv4.Validate("not-an-ip")   // fail
-var IPv4 Rule = RuleFunc(
+var IPv4 Rule = withFormat("ipv4", RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return basicError{"ipv4", "ipv4 validation failed"}
 		}
@@ -81,7 +81,7 @@ var IPv4 Rule = RuleFunc(
 
 		return nil
 	},
-)
+))
 
 // IPv6 is a Rule that validates the value is a valid IPv6 address.
 //
@@ -95,9 +95,9 @@ var IPv4 Rule = RuleFunc(
 //	validation.IPv6.Validate("2001:db8::1")   // pass
 //	validation.IPv6.Validate("192.168.1.1")   // fail — IPv4
 //	validation.IPv6.Validate("not-an-ip")     // fail
-var IPv6 Rule = RuleFunc(
+var IPv6 Rule = withFormat("ipv6", RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return basicError{"ipv6", "ipv6 validation failed"}
 		}
@@ -109,7 +109,7 @@ var IPv6 Rule = RuleFunc(
 
 		return nil
 	},
-)
+))
 
 // MACAddress is a Rule that validates the value is a valid 6-byte MAC address.
 //
@@ -127,7 +127,7 @@ var IPv6 Rule = RuleFunc(
 //	validation.MACAddress.Validate("not-a-mac")         // fail
 var MACAddress Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return basicError{"mac_address", "mac address validation failed"}
 		}
@@ -160,9 +160,9 @@ var MACAddress Rule = RuleFunc(
 //	validation.URL.Validate("http://[::1]:8080/api") // pass — IPv6
 //	validation.URL.Validate("not a url")             // fail — unparseable
 //	validation.URL.Validate("http://")               // fail — no host
-var URL Rule = RuleFunc(
+var URL Rule = withFormat("uri", RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return basicError{"url", "url validation failed"}
 		}
@@ -176,7 +176,7 @@ var URL Rule = RuleFunc(
 
 		return basicError{"url", "url validation failed"}
 	},
-)
+))
 
 func isValidURLHost(host string) bool {
 	if host == "" {
@@ -17,7 +17,7 @@ type number interface {
 // the field value is typed as any.
 //
 // Fails if:
-//   - value cannot be type-asserted to T
+//   - value cannot be type-asserted to T, or converted to T in loose mode (see Loose)
 //   - value < min
 //   - value > max
 //
@@ -30,8 +30,8 @@ type number interface {
 //	validation.Between[int](1, 100).Validate(101)       // fail — above max
 //	validation.Between[float64](0.0, 1.0).Validate(0.5) // pass
 func Between[T number](minV, maxV T) Rule {
-	fn := func(value any) error {
-		v, ok := value.(T)
+	fn := func(value any, input *InputBag) error {
+		v, ok := numberAs[T](value, input)
 		if !ok || v < minV || v > maxV {
 			return betweenError{Min: minV, Max: maxV}
 		}
@@ -39,7 +39,7 @@ func Between[T number](minV, maxV T) Rule {
 		return nil
 	}
 
-	return RuleFunc(fn)
+	return withKeywords("number", map[string]any{"minimum": minV, "maximum": maxV}, InputRuleFunc(fn))
 }
 
 // GT returns a Rule that validates the value is strictly greater than v.
@@ -47,7 +47,7 @@ func Between[T number](minV, maxV T) Rule {
 // Unlike Min (which uses >=), GT uses >, so equal values fail.
 //
 // Fails if:
-//   - value cannot be type-asserted to T
+//   - value cannot be type-asserted to T, or converted to T in loose mode (see Loose)
 //   - value <= v
 //
 // Examples:
@@ -56,16 +56,16 @@ func Between[T number](minV, maxV T) Rule {
 //	validation.GT[int](18).Validate(18)  // fail — equal
 //	validation.GT[int](18).Validate(17)  // fail
 func GT[T number](v T) Rule {
-	return RuleFunc(
-		func(value any) error {
-			actual, ok := value.(T)
+	return withKeywords("number", map[string]any{"exclusiveMinimum": v}, InputRuleFunc(
+		func(value any, input *InputBag) error {
+			actual, ok := numberAs[T](value, input)
 			if !ok || actual <= v {
 				return gtError{Value: v}
 			}
 
 			return nil
 		},
-	)
+	))
 }
 
 // GTE returns a Rule that validates the value is greater than or equal to v.
@@ -73,7 +73,7 @@ func GT[T number](v T) Rule {
 // GTE is semantically identical to Min; it exists as an explicit named alias.
 //
 // Fails if:
-//   - value cannot be type-asserted to T
+//   - value cannot be type-asserted to T, or converted to T in loose mode (see Loose)
 //   - value < v
 //
 // Examples:
@@ -82,26 +82,27 @@ func GT[T number](v T) Rule {
 //	validation.GTE[int](18).Validate(19)  // pass
 //	validation.GTE[int](18).Validate(17)  // fail
 func GTE[T number](v T) Rule {
-	return RuleFunc(
-		func(value any) error {
-			actual, ok := value.(T)
+	return withKeywords("number", map[string]any{"minimum": v}, InputRuleFunc(
+		func(value any, input *InputBag) error {
+			actual, ok := numberAs[T](value, input)
 			if !ok || actual < v {
 				return gteError{Value: v}
 			}
 
 			return nil
 		},
-	)
+	))
 }
 
 // Integer is a Rule that validates the value is an integer type.
 //
 // Accepted kinds: int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64.
 // Note: float64 (the default JSON number type) is rejected — use this rule when you need
-// to assert the Go type is strictly integral.
+// to assert the Go type is strictly integral. In loose mode (see Loose) any number or numeric string with an integral
+// value passes instead, so float64(3) and "3" are accepted on decoded input.
 //
 // Fails if:
-//   - value is not one of the integer kinds above
+//   - value is not one of the integer kinds above, or in loose mode does not hold an integral value
 //
 // Examples:
 //
@@ -109,8 +110,18 @@ func GTE[T number](v T) Rule {
 //	validation.Integer.Validate(uint8(255))  // pass
 //	validation.Integer.Validate(3.14)        // fail — float64
 //	validation.Integer.Validate("42")        // fail — string
-var Integer Rule = RuleFunc(
-	func(value any) error {
+var Integer Rule = withKeywords("integer", nil, InputRuleFunc(
+	func(value any, input *InputBag) error {
+		if input.Loose() {
+			if _, ok := coerceNumber[int64](value); ok {
+				return nil
+			}
+			if _, ok := coerceNumber[uint64](value); ok {
+				return nil
+			}
+			return basicError{"integer", "integer validation failed"}
+		}
+
 		switch reflect.ValueOf(value).Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
 			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
@@ -119,14 +130,14 @@ var Integer Rule = RuleFunc(
 			return basicError{"integer", "integer validation failed"}
 		}
 	},
-)
+))
 
 // Latitude is a Rule that validates the value is a valid latitude (−90 to 90 inclusive).
 //
 // Accepts any numeric type or float64 (the default JSON number type).
 //
 // Fails if:
-//   - value is not a numeric type
+//   - value is not a numeric type, or in loose mode cannot be converted to a number (see Loose)
 //   - value < -90 or value > 90
 //
 // Examples:
@@ -135,9 +146,9 @@ var Integer Rule = RuleFunc(
 //	validation.Latitude.Validate(-90.0)   // pass — inclusive
 //	validation.Latitude.Validate(90.1)    // fail
 //	validation.Latitude.Validate("45.0")  // fail — string not accepted
-var Latitude Rule = RuleFunc(
-	func(value any) error {
-		fv, ok := condToFloat(value)
+var Latitude Rule = InputRuleFunc(
+	func(value any, input *InputBag) error {
+		fv, ok := floatValue(value, input)
 		if !ok || fv < -90 || fv > 90 {
 			return basicError{"latitude", "latitude validation failed"}
 		}
@@ -151,7 +162,7 @@ var Latitude Rule = RuleFunc(
 // Accepts any numeric type or float64 (the default JSON number type).
 //
 // Fails if:
-//   - value is not a numeric type
+//   - value is not a numeric type, or in loose mode cannot be converted to a number (see Loose)
 //   - value < -180 or value > 180
 //
 // Examples:
@@ -160,9 +171,9 @@ var Latitude Rule = RuleFunc(
 //	validation.Longitude.Validate(-180.0)  // pass — inclusive
 //	validation.Longitude.Validate(180.1)   // fail
 //	validation.Longitude.Validate("120.5") // fail — string not accepted
-var Longitude Rule = RuleFunc(
-	func(value any) error {
-		fv, ok := condToFloat(value)
+var Longitude Rule = InputRuleFunc(
+	func(value any, input *InputBag) error {
+		fv, ok := floatValue(value, input)
 		if !ok || fv < -180 || fv > 180 {
 			return basicError{"longitude", "longitude validation failed"}
 		}
@@ -176,7 +187,7 @@ var Longitude Rule = RuleFunc(
 // Unlike Max (which uses <=), LT uses <, so equal values fail.
 //
 // Fails if:
-//   - value cannot be type-asserted to T
+//   - value cannot be type-asserted to T, or converted to T in loose mode (see Loose)
 //   - value >= v
 //
 // Examples:
@@ -185,16 +196,16 @@ var Longitude Rule = RuleFunc(
 //	validation.LT[int](100).Validate(100)  // fail — equal
 //	validation.LT[int](100).Validate(101)  // fail
 func LT[T number](v T) Rule {
-	return RuleFunc(
-		func(value any) error {
-			actual, ok := value.(T)
+	return withKeywords("number", map[string]any{"exclusiveMaximum": v}, InputRuleFunc(
+		func(value any, input *InputBag) error {
+			actual, ok := numberAs[T](value, input)
 			if !ok || actual >= v {
 				return ltError{Value: v}
 			}
 
 			return nil
 		},
-	)
+	))
 }
 
 // LTE returns a Rule that validates the value is less than or equal to v.
@@ -202,7 +213,7 @@ func LT[T number](v T) Rule {
 // LTE is semantically identical to Max; it exists as an explicit named alias.
 //
 // Fails if:
-//   - value cannot be type-asserted to T
+//   - value cannot be type-asserted to T, or converted to T in loose mode (see Loose)
 //   - value > v
 //
 // Examples:
@@ -211,16 +222,16 @@ func LT[T number](v T) Rule {
 //	validation.LTE[int](100).Validate(99)   // pass
 //	validation.LTE[int](100).Validate(101)  // fail
 func LTE[T number](v T) Rule {
-	return RuleFunc(
-		func(value any) error {
-			actual, ok := value.(T)
+	return withKeywords("number", map[string]any{"maximum": v}, InputRuleFunc(
+		func(value any, input *InputBag) error {
+			actual, ok := numberAs[T](value, input)
 			if !ok || actual > v {
 				return lteError{Value: v}
 			}
 
 			return nil
 		},
-	)
+	))
 }
 
 // Max returns a Rule that validates the value is at most maxV.
@@ -228,7 +239,7 @@ func LTE[T number](v T) Rule {
 // The type parameter T must be instantiated explicitly.
 //
 // Fails if:
-//   - value cannot be type-asserted to T
+//   - value cannot be type-asserted to T, or converted to T in loose mode (see Loose)
 //   - value > max
 //
 // Examples:
@@ -238,8 +249,8 @@ func LTE[T number](v T) Rule {
 //	validation.Max[int](100).Validate(101)      // fail — above max
 //	validation.Max[float64](1.0).Validate(1.1)  // fail
 func Max[T number](maxV T) Rule {
-	fn := func(value any) error {
-		v, ok := value.(T)
+	fn := func(value any, input *InputBag) error {
+		v, ok := numberAs[T](value, input)
 		if !ok || v > maxV {
 			return maxError{Value: maxV}
 		}
@@ -247,7 +258,7 @@ func Max[T number](maxV T) Rule {
 		return nil
 	}
 
-	return RuleFunc(fn)
+	return withKeywords("number", map[string]any{"maximum": maxV}, InputRuleFunc(fn))
 }
 
 // Min returns a Rule that validates the value is at least minV.
@@ -255,7 +266,7 @@ func Max[T number](maxV T) Rule {
 // The type parameter T must be instantiated explicitly.
 //
 // Fails if:
-//   - value cannot be type-asserted to T
+//   - value cannot be type-asserted to T, or converted to T in loose mode (see Loose)
 //   - value < min
 //
 // Examples:
@@ -265,8 +276,8 @@ func Max[T number](maxV T) Rule {
 //	validation.Min[int](18).Validate(17)        // fail — below min
 //	validation.Min[float64](0.5).Validate(0.4)  // fail
 func Min[T number](minV T) Rule {
-	fn := func(value any) error {
-		v, ok := value.(T)
+	fn := func(value any, input *InputBag) error {
+		v, ok := numberAs[T](value, input)
 		if !ok || v < minV {
 			return minError{Value: minV}
 		}
@@ -274,7 +285,7 @@ func Min[T number](minV T) Rule {
 		return nil
 	}
 
-	return RuleFunc(fn)
+	return withKeywords("number", map[string]any{"minimum": minV}, InputRuleFunc(fn))
 }
 
 // MultipleOf returns a Rule that validates the value is a multiple of n.
@@ -283,7 +294,7 @@ func Min[T number](minV T) Rule {
 // If n is zero, Schema.Validate returns a RuleSyntaxError.
 //
 // Fails if:
-//   - value is not a numeric type
+//   - value is not a numeric type, or in loose mode cannot be converted to a number (see Loose)
 //   - value is not evenly divisible by n
 //
 // Examples:
@@ -292,13 +303,13 @@ func Min[T number](minV T) Rule {
 //	validation.MultipleOf[int](3).Validate(float64(9)) // pass — JSON number accepted
 //	validation.MultipleOf[int](3).Validate(8)          // fail
 func MultipleOf[T number](n T) Rule {
-	return RuleFunc(
-		func(value any) error {
+	return withKeywords("number", map[string]any{"multipleOf": n}, InputRuleFunc(
+		func(value any, input *InputBag) error {
 			if float64(n) == 0 {
 				return RuleSyntaxError{Rule: "MultipleOf", Err: errors.New("divisor must not be zero")}
 			}
 
-			fv, ok := condToFloat(value)
+			fv, ok := floatValue(value, input)
 			if !ok {
 				return multipleOfError{Value: n}
 			}
@@ -309,7 +320,7 @@ func MultipleOf[T number](n T) Rule {
 
 			return nil
 		},
-	)
+	))
 }
 
 // Negative is a Rule that validates the value is strictly less than zero.
@@ -317,7 +328,7 @@ func MultipleOf[T number](n T) Rule {
 // Accepts any numeric type.
 //
 // Fails if:
-//   - value is not a numeric type
+//   - value is not a numeric type, or in loose mode cannot be converted to a number (see Loose)
 //   - value >= 0
 //
 // Examples:
@@ -326,23 +337,23 @@ func MultipleOf[T number](n T) Rule {
 //	validation.Negative.Validate(-0.5) // pass
 //	validation.Negative.Validate(0)    // fail — zero is not negative
 //	validation.Negative.Validate(1)    // fail
-var Negative Rule = RuleFunc(
-	func(value any) error {
-		fv, ok := condToFloat(value)
+var Negative Rule = withKeywords("number", map[string]any{"exclusiveMaximum": 0}, InputRuleFunc(
+	func(value any, input *InputBag) error {
+		fv, ok := floatValue(value, input)
 		if !ok || fv >= 0 {
 			return basicError{"negative", "negative validation failed"}
 		}
 
 		return nil
 	},
-)
+))
 
 // NonNegative is a Rule that validates the value is greater than or equal to zero.
 //
 // Accepts any numeric type.
 //
 // Fails if:
-//   - value is not a numeric type
+//   - value is not a numeric type, or in loose mode cannot be converted to a number (see Loose)
 //   - value < 0
 //
 // Examples:
@@ -351,21 +362,22 @@ var Negative Rule = RuleFunc(
 //	validation.NonNegative.Validate(5)    // pass
 //	validation.NonNegative.Validate(-1)   // fail
 //	validation.NonNegative.Validate(-0.1) // fail
-var NonNegative Rule = RuleFunc(
-	func(value any) error {
-		fv, ok := condToFloat(value)
+var NonNegative Rule = withKeywords("number", map[string]any{"minimum": 0}, InputRuleFunc(
+	func(value any, input *InputBag) error {
+		fv, ok := floatValue(value, input)
 		if !ok || fv < 0 {
 			return basicError{"non_negative", "non negative validation failed"}
 		}
 
 		return nil
 	},
-)
+))
 
 // Numeric is a Rule that validate the value is a number, or it can be converted to a number.
 //
 // Accepted types: int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, complex64,
-// complex128, and any string whose content is a valid decimal number.
+// complex128, and any string whose content is a valid decimal number. In loose mode (see Loose) named numeric and
+// string types and json.Number are accepted as well.
 //
 // Fails if:
 //   - value is nil or a boolean
@@ -379,8 +391,8 @@ var NonNegative Rule = RuleFunc(
 //	validation.Numeric.Validate("99.5")   // pass — parseable string
 //	validation.Numeric.Validate("abc")    // fail — not a number
 //	validation.Numeric.Validate(true)     // fail — boolean not accepted
-var Numeric Rule = RuleFunc(
-	func(value any) error {
+var Numeric Rule = InputRuleFunc(
+	func(value any, input *InputBag) error {
 		switch v := value.(type) {
 		case int, int8, int16, int32, int64,
 			uint, uint8, uint16, uint32, uint64,
@@ -395,6 +407,9 @@ var Numeric Rule = RuleFunc(
 
 			return nil
 		default:
+			if _, ok := coerceNumber[float64](value); ok && input.Loose() {
+				return nil
+			}
 			return basicError{"numeric", "numeric validation failed"}
 		}
 	},
@@ -405,7 +420,7 @@ var Numeric Rule = RuleFunc(
 // Accepts any numeric type. Fractional values (e.g. float64(80.5)) fail.
 //
 // Fails if:
-//   - value is not a numeric type
+//   - value is not a numeric type, or in loose mode cannot be converted to a number (see Loose)
 //   - value is fractional
 //   - value < 1 or value > 65535
 //
@@ -416,9 +431,9 @@ var Numeric Rule = RuleFunc(
 //	validation.Port.Validate(0)              // fail — port 0 is reserved
 //	validation.Port.Validate(65536)          // fail
 //	validation.Port.Validate(80.5)           // fail — fractional
-var Port Rule = RuleFunc(
-	func(value any) error {
-		fv, ok := condToFloat(value)
+var Port Rule = InputRuleFunc(
+	func(value any, input *InputBag) error {
+		fv, ok := floatValue(value, input)
 		if !ok || fv != math.Trunc(fv) || fv < 1 || fv > 65535 {
 			return basicError{"port", "port validation failed"}
 		}
@@ -432,7 +447,7 @@ var Port Rule = RuleFunc(
 // Accepts any numeric type.
 //
 // Fails if:
-//   - value is not a numeric type
+//   - value is not a numeric type, or in loose mode cannot be converted to a number (see Loose)
 //   - value <= 0
 //
 // Examples:
@@ -441,13 +456,13 @@ var Port Rule = RuleFunc(
 //	validation.Positive.Validate(0.1)  // pass
 //	validation.Positive.Validate(0)    // fail — zero is not positive
 //	validation.Positive.Validate(-1)   // fail
-var Positive Rule = RuleFunc(
-	func(value any) error {
-		fv, ok := condToFloat(value)
+var Positive Rule = withKeywords("number", map[string]any{"exclusiveMinimum": 0}, InputRuleFunc(
+	func(value any, input *InputBag) error {
+		fv, ok := floatValue(value, input)
 		if !ok || fv <= 0 {
 			return basicError{"positive", "positive validation failed"}
 		}
 
 		return nil
 	},
-)
+))
@@ -0,0 +1,89 @@
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemContentType is the media type of an RFC 7807 problem details document.
+const ProblemContentType = "application/problem+json"
+
+// fieldErrorJSON is the encoded form of a FieldError.
+type fieldErrorJSON struct {
+	Path    string         `json:"path"`
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Label   string         `json:"label,omitempty"`
+	Params  map[string]any `json:"params,omitempty"`
+}
+
+// MarshalJSON encodes the error as an object with its path, code, message, and, when present, label and params. The
+// underlying Err is left out; its text is the message.
+//
+// Example output:
+//
+//	{"path":"name","code":"min_length","message":"min length validation failed","params":{"length":3}}
+func (e FieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		fieldErrorJSON{Path: e.Path, Code: e.Code, Message: e.Message, Label: e.Label, Params: e.Params},
+	)
+}
+
+// MarshalJSON encodes the result as an array of its field errors, or [] when validation succeeded.
+func (r *Result) MarshalJSON() ([]byte, error) {
+	if r.errors == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(r.errors)
+}
+
+// Problem is an RFC 7807 problem details object describing a failed validation, with the field errors in the errors
+// extension member. Fields may be adjusted before the problem is written, e.g. to set Type to a documentation URL or
+// Instance to the request path.
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty"`
+	Errors   []FieldError `json:"errors"`
+}
+
+// Problem returns the result as a problem with status 422 Unprocessable Entity. Messages are taken as they are, so
+// localize the result first when needed.
+//
+// Example:
+//
+//	if res.HasErrors() {
+//		_ = res.Localize(lang).Problem().Write(w)
+//		return
+//	}
+func (r *Result) Problem() *Problem {
+	errs := r.errors
+	if errs == nil {
+		errs = []FieldError{}
+	}
+
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusUnprocessableEntity),
+		Status: http.StatusUnprocessableEntity,
+		Detail: "The request failed validation.",
+		Errors: errs,
+	}
+}
+
+// Write sends the problem as an application/problem+json response with its status code.
+func (p *Problem) Write(w http.ResponseWriter) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	_, err = w.Write(body)
+
+	return err
+}
@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResult_MarshalJSON(t *testing.T) {
+	schema := New().
+		Field("name", Required, MinLength(3)).Label("Name").
+		Field("role", In([]string{"admin", "editor"}))
+
+	res, err := schema.Validate(map[string]any{"name": "X", "role": "guest"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[` +
+		`{"path":"name","code":"min_length","message":"Name must be at least 3 characters","label":"Name",` +
+		`"params":{"length":3}},` +
+		`{"path":"role","code":"in","message":"in validation failed","params":{"values":["admin","editor"]}}` +
+		`]`
+	if string(got) != want {
+		t.Errorf("json = %s\nwant %s", got, want)
+	}
+
+	got, err = json.Marshal(&Result{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "[]" {
+		t.Errorf("empty result json = %s, want []", got)
+	}
+}
+
+func TestProblem_Write(t *testing.T) {
+	res, err := New().Field("email", Required).Validate(map[string]any{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	problem := res.Problem()
+	problem.Instance = "/users"
+
+	rec := httptest.NewRecorder()
+	if err := problem.Write(rec); err != nil {
+		t.Fatal(err)
+	}
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want 422", rec.Code)
+	}
+	if got := rec.Header().Get("Content-Type"); got != ProblemContentType {
+		t.Errorf("content type = %q", got)
+	}
+	want := `{"type":"about:blank","title":"Unprocessable Entity","status":422,` +
+		`"detail":"The request failed validation.","instance":"/users",` +
+		`"errors":[{"path":"email","code":"required","message":"required validation failed"}]}`
+	if rec.Body.String() != want {
+		t.Errorf("body = %s\nwant %s", rec.Body.String(), want)
+	}
+}
@@ -0,0 +1,689 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// RuleFactory builds a Rule from the textual parameters of a named rule, e.g. ["255"] for "max_length:255". It returns
+// an error describing a wrong parameter count or a malformed parameter; the Registry wraps it in a RuleSyntaxError
+// that names the rule.
+//
+// The helpers NoParams, StringParam, StringListParam, IntParam, IntPairParam, FloatParam, TimeParam, and TimePairParam
+// adapt ordinary rule constructors into factories with typed parameter parsing.
+type RuleFactory func(params []string) (Rule, error)
+
+// Registry maps textual rule names to rule factories. Every textual schema format — FromStruct tags, ParseRules and
+// FromMap strings — resolves rule names through a Registry; the package-level functions use the built-in rules.
+//
+// Create a Registry with NewRegistry to add domain rules without forking the package:
+//
+//	reg := validation.NewRegistry()
+//	_ = reg.Register("sku", validation.NoParams(skuRule))
+//	_ = reg.Register("divisible_by", validation.IntParam(func(n int) validation.Rule {
+//		return validation.MultipleOf[int](n)
+//	}))
+//
+//	schema, err := reg.FromMap(map[string]string{"code": "required|sku", "qty": "divisible_by:6"})
+//
+// A Registry is safe for concurrent use; register rules before building schemas from it.
+type Registry struct {
+	mu    sync.RWMutex
+	rules map[string]RuleFactory
+}
+
+// defaultRegistry holds the built-in rules. It is never modified; NewRegistry hands out copies.
+var defaultRegistry = &Registry{rules: builtinRules()}
+
+var regexRuleName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]*$`)
+
+// NewRegistry returns a Registry pre-populated with every built-in rule under its textual name. Rule names are the
+// error codes the rules report, so a failure with code "max_length" comes from the rule spelled "max_length".
+func NewRegistry() *Registry {
+	return &Registry{rules: builtinRules()}
+}
+
+// Register adds a rule factory under name. Registering an existing name replaces it, which lets a rule pack override a
+// built-in rule. Names start with a letter and may contain letters, digits, "_", "." and "-".
+func (r *Registry) Register(name string, factory RuleFactory) error {
+	if !regexRuleName.MatchString(name) {
+		return fmt.Errorf("validation: invalid rule name %q", name)
+	}
+	if factory == nil {
+		return fmt.Errorf("validation: nil factory for rule %q", name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules[name] = factory
+
+	return nil
+}
+
+// Rule builds the named rule with the given textual parameters. An unknown name or malformed parameters are reported
+// as a RuleSyntaxError.
+//
+// Example:
+//
+//	rule, err := reg.Rule("between", "1", "100")
+func (r *Registry) Rule(name string, params ...string) (Rule, error) {
+	r.mu.RLock()
+	factory, ok := r.rules[name]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, RuleSyntaxError{Rule: name, Err: errors.New("unknown rule")}
+	}
+
+	rule, err := factory(params)
+	if err != nil {
+		return nil, RuleSyntaxError{Rule: name, Err: err}
+	}
+
+	return rule, nil
+}
+
+// Names returns the registered rule names in sorted order.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.rules))
+	for name := range r.rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// builtinRules returns a fresh map of the built-in rule factories.
+//
+// Values in textual schemas carry no Go type, so the numeric rules built here (min, max, between, ...) accept any
+// numeric kind instead of one exact type parameter.
+func builtinRules() map[string]RuleFactory {
+	return map[string]RuleFactory{
+		// General
+		"bail":                 NoParams(bailRule{}),
+		"loose":                NoParams(looseRule{}),
+		"required":             NoParams(Required),
+		"required_if":          conditionParam(func(c string) Rule { return RequiredIf(c) }),
+		"required_unless":      conditionParam(func(c string) Rule { return RequiredUnless(c) }),
+		"required_with":        StringListParam(func(p ...string) Rule { return RequiredWith(p...) }),
+		"required_with_all":    StringListParam(func(p ...string) Rule { return RequiredWithAll(p...) }),
+		"required_without":     StringListParam(func(p ...string) Rule { return RequiredWithout(p...) }),
+		"required_without_all": StringListParam(func(p ...string) Rule { return RequiredWithoutAll(p...) }),
+		"not_empty":            NoParams(NotEmpty),
+
+		// String
+		"alpha":       NoParams(Alpha),
+		"alpha_dash":  NoParams(AlphaDash),
+		"alpha_num":   NoParams(AlphaNum),
+		"alpha_space": NoParams(AlphaSpace),
+		"ascii":       NoParams(ASCII),
+		"base64":      NoParams(Base64),
+		"contains":    StringParam(Contains),
+		"credit_card": NoParams(CreditCard),
+		"email":       NoParams(Email),
+		"email_mx":    NoParams(EmailMX),
+		"ends_with":   StringParam(EndsWith),
+		"hex_color":   NoParams(HexColor),
+		"json":        NoParams(JSON),
+		"jwt":         NoParams(JWT),
+		"length":      IntParam(Length),
+		"lowercase":   NoParams(Lowercase),
+		"max_length":  IntParam(MaxLength),
+		"min_length":  IntParam(MinLength),
+		"not_regex":   patternParam(NotRegex),
+		"phone_e164":  NoParams(PhoneE164),
+		"regex":       patternParam(Regex),
+		"semver":      NoParams(Semver),
+		"slug":        NoParams(Slug),
+		"starts_with": StringParam(StartsWith),
+		"uppercase":   NoParams(Uppercase),
+		"uuid":        NoParams(UUID),
+
+		// Number
+		"between": numberBetween,
+		"gt": numberCompare(
+			"exclusiveMinimum",
+			func(v, limit float64) bool { return v > limit },
+			func(limit any) error { return gtError{Value: limit} },
+		),
+		"gte": numberCompare(
+			"minimum",
+			func(v, limit float64) bool { return v >= limit },
+			func(limit any) error { return gteError{Value: limit} },
+		),
+		"integer":   NoParams(Integer),
+		"latitude":  NoParams(Latitude),
+		"longitude": NoParams(Longitude),
+		"lt": numberCompare(
+			"exclusiveMaximum",
+			func(v, limit float64) bool { return v < limit },
+			func(limit any) error { return ltError{Value: limit} },
+		),
+		"lte": numberCompare(
+			"maximum",
+			func(v, limit float64) bool { return v <= limit },
+			func(limit any) error { return lteError{Value: limit} },
+		),
+		"max": numberCompare(
+			"maximum",
+			func(v, limit float64) bool { return v <= limit },
+			func(limit any) error { return maxError{Value: limit} },
+		),
+		"min": numberCompare(
+			"minimum",
+			func(v, limit float64) bool { return v >= limit },
+			func(limit any) error { return minError{Value: limit} },
+		),
+		"multiple_of":  numberMultipleOf,
+		"negative":     NoParams(Negative),
+		"non_negative": NoParams(NonNegative),
+		"numeric":      NoParams(Numeric),
+		"port":         NoParams(Port),
+		"positive":     NoParams(Positive),
+
+		// Digit
+		"digits":         IntParam(Digits),
+		"digits_between": IntPairParam(DigitsBetween),
+		"max_digits":     IntParam(MaxDigits),
+		"min_digits":     IntParam(MinDigits),
+
+		// DateTime
+		"after":             TimeParam(After),
+		"after_field":       StringParam(func(p string) Rule { return AfterField(p) }),
+		"after_or_equal":    TimeParam(AfterOrEqual),
+		"before":            TimeParam(Before),
+		"before_field":      StringParam(func(p string) Rule { return BeforeField(p) }),
+		"before_or_equal":   TimeParam(BeforeOrEqual),
+		"date_time":         NoParams(DateTime),
+		"date_time_between": TimePairParam(DateTimeBetween),
+		"date_time_format":  StringParam(DateTimeFormat),
+		"timezone":          NoParams(Timezone),
+
+		// Network
+		"cidr":        NoParams(CIDR),
+		"ip":          NoParams(IP),
+		"ipv4":        NoParams(IPv4),
+		"ipv6":        NoParams(IPv6),
+		"mac_address": NoParams(MACAddress),
+		"url":         NoParams(URL),
+
+		// Collection
+		"distinct": NoParams(Distinct),
+		"max_size": IntParam(MaxSize),
+		"min_size": IntParam(MinSize),
+		"size":     IntParam(Size),
+
+		// File
+		"file_type":     StringListParam(func(t ...string) Rule { return FileType(t...) }),
+		"max_file_size": IntParam(func(n int) Rule { return MaxFileSize(int64(n)) }),
+
+		// Generic
+		"in":     textIn,
+		"not_in": textNotIn,
+		"neq":    textNEQ,
+
+		// Comparison
+		"different": StringParam(func(p string) Rule { return Different(p) }),
+		"same_as":   StringParam(func(p string) Rule { return SameAs(p) }),
+	}
+}
+
+// NoParams returns a factory for a rule that takes no parameters.
+func NoParams(r Rule) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) != 0 {
+			return nil, fmt.Errorf("expects no parameters, got %d", len(params))
+		}
+		return r, nil
+	}
+}
+
+// StringParam returns a factory for a rule that takes exactly one string parameter.
+func StringParam(fn func(string) Rule) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) != 1 {
+			return nil, fmt.Errorf("expects 1 parameter, got %d", len(params))
+		}
+		return fn(params[0]), nil
+	}
+}
+
+// conditionParam is StringParam for a rule taking a condition, which is checked when the rule is built.
+func conditionParam(fn func(string) Rule) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) != 1 {
+			return nil, fmt.Errorf("expects 1 parameter, got %d", len(params))
+		}
+		if err := checkCondition(params[0]); err != nil {
+			return nil, err
+		}
+		return fn(params[0]), nil
+	}
+}
+
+// StringListParam returns a factory for a rule that takes one or more string parameters.
+func StringListParam(fn func(...string) Rule) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) == 0 {
+			return nil, errors.New("expects at least 1 parameter")
+		}
+		return fn(params...), nil
+	}
+}
+
+// IntParam returns a factory for a rule that takes exactly one integer parameter.
+func IntParam(fn func(int) Rule) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) != 1 {
+			return nil, fmt.Errorf("expects 1 parameter, got %d", len(params))
+		}
+		n, err := parseIntParam(params[0])
+		if err != nil {
+			return nil, err
+		}
+		return fn(n), nil
+	}
+}
+
+// IntPairParam returns a factory for a rule that takes exactly two integer parameters, such as a min and a max.
+func IntPairParam(fn func(int, int) Rule) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) != 2 {
+			return nil, fmt.Errorf("expects 2 parameters, got %d", len(params))
+		}
+		a, err := parseIntParam(params[0])
+		if err != nil {
+			return nil, err
+		}
+		b, err := parseIntParam(params[1])
+		if err != nil {
+			return nil, err
+		}
+		return fn(a, b), nil
+	}
+}
+
+// FloatParam returns a factory for a rule that takes exactly one numeric parameter.
+func FloatParam(fn func(float64) Rule) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) != 1 {
+			return nil, fmt.Errorf("expects 1 parameter, got %d", len(params))
+		}
+		_, f, err := numberParam(params[0])
+		if err != nil {
+			return nil, err
+		}
+		return fn(f), nil
+	}
+}
+
+// TimeParam returns a factory for a rule that takes exactly one date/time parameter, in any of the formats accepted by
+// the DateTime rule.
+func TimeParam(fn func(time.Time) Rule) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) != 1 {
+			return nil, fmt.Errorf("expects 1 parameter, got %d", len(params))
+		}
+		t, err := parseTimeParam(params[0])
+		if err != nil {
+			return nil, err
+		}
+		return fn(t), nil
+	}
+}
+
+// TimePairParam returns a factory for a rule that takes exactly two date/time parameters.
+func TimePairParam(fn func(time.Time, time.Time) Rule) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) != 2 {
+			return nil, fmt.Errorf("expects 2 parameters, got %d", len(params))
+		}
+		a, err := parseTimeParam(params[0])
+		if err != nil {
+			return nil, err
+		}
+		b, err := parseTimeParam(params[1])
+		if err != nil {
+			return nil, err
+		}
+		return fn(a, b), nil
+	}
+}
+
+// patternParam builds a regex rule and reports an invalid pattern when the schema is built rather than at Validate.
+func patternParam(fn func(string) Rule) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) != 1 {
+			return nil, fmt.Errorf("expects 1 parameter, got %d", len(params))
+		}
+		r := fn(params[0])
+		var rse RuleSyntaxError
+		if err := r.Validate(""); errors.As(err, &rse) {
+			return nil, rse.Err
+		}
+		return r, nil
+	}
+}
+
+func parseIntParam(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("parameter %q is not an integer", s)
+	}
+	return n, nil
+}
+
+func parseTimeParam(s string) (time.Time, error) {
+	t, ok := parseTime(s)
+	if !ok {
+		return time.Time{}, fmt.Errorf("parameter %q is not a date/time", s)
+	}
+	return t, nil
+}
+
+// numberParam parses a numeric parameter. The first result keeps integers as int so that error Params look the same
+// as those of the hand-written rules, e.g. {"value": 18} rather than {"value": 18.0}.
+func numberParam(s string) (any, float64, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, float64(n), nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, 0, fmt.Errorf("parameter %q is not a number", s)
+	}
+
+	return f, f, nil
+}
+
+// numberValue converts a value of any numeric kind, including named numeric types, to float64.
+func numberValue(value any) (float64, bool) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// looseNumberValue is numberValue, converting as coerceNumber does when input is in loose mode.
+func looseNumberValue(value any, input *InputBag) (float64, bool) {
+	if input.Loose() {
+		return coerceNumber[float64](value)
+	}
+
+	return numberValue(value)
+}
+
+// numberCompare builds a single-limit numeric rule that passes when ok(value, limit) holds. keyword is the JSON Schema
+// keyword describing the limit.
+func numberCompare(keyword string, ok func(v, limit float64) bool, fail func(limit any) error) RuleFactory {
+	return func(params []string) (Rule, error) {
+		if len(params) != 1 {
+			return nil, fmt.Errorf("expects 1 parameter, got %d", len(params))
+		}
+		limit, lf, err := numberParam(params[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return withKeywords("number", map[string]any{keyword: limit}, InputRuleFunc(
+			func(value any, input *InputBag) error {
+				v, isNum := looseNumberValue(value, input)
+				if !isNum || !ok(v, lf) {
+					return fail(limit)
+				}
+				return nil
+			},
+		)), nil
+	}
+}
+
+func numberBetween(params []string) (Rule, error) {
+	if len(params) != 2 {
+		return nil, fmt.Errorf("expects 2 parameters, got %d", len(params))
+	}
+	minV, minF, err := numberParam(params[0])
+	if err != nil {
+		return nil, err
+	}
+	maxV, maxF, err := numberParam(params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return withKeywords("number", map[string]any{"minimum": minV, "maximum": maxV}, InputRuleFunc(
+		func(value any, input *InputBag) error {
+			v, ok := looseNumberValue(value, input)
+			if !ok || v < minF || v > maxF {
+				return betweenError{Min: minV, Max: maxV}
+			}
+			return nil
+		},
+	)), nil
+}
+
+func numberMultipleOf(params []string) (Rule, error) {
+	if len(params) != 1 {
+		return nil, fmt.Errorf("expects 1 parameter, got %d", len(params))
+	}
+	n, nf, err := numberParam(params[0])
+	if err != nil {
+		return nil, err
+	}
+	if nf == 0 {
+		return nil, errors.New("divisor must not be zero")
+	}
+
+	return withKeywords("number", map[string]any{"multipleOf": n}, InputRuleFunc(
+		func(value any, input *InputBag) error {
+			v, ok := looseNumberValue(value, input)
+			if !ok || math.Mod(v, nf) != 0 {
+				return multipleOfError{Value: n}
+			}
+			return nil
+		},
+	)), nil
+}
+
+// textEqual reports whether value matches a textual parameter: strings compare as text, numbers numerically, and
+// booleans against "true" / "false".
+func textEqual(value any, param string) bool {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String() == param
+	case reflect.Bool:
+		b, err := strconv.ParseBool(param)
+		return err == nil && rv.Bool() == b
+	}
+
+	v, ok := numberValue(value)
+	if !ok {
+		return false
+	}
+	_, p, err := numberParam(param)
+
+	return err == nil && v == p
+}
+
+func textIn(params []string) (Rule, error) {
+	if len(params) == 0 {
+		return nil, errors.New("expects at least 1 parameter")
+	}
+
+	return withKeywords("", map[string]any{"enum": textEnum(params)}, RuleFunc(
+		func(value any) error {
+			if !slices.ContainsFunc(params, func(p string) bool { return textEqual(value, p) }) {
+				return inError{Values: params}
+			}
+			return nil
+		},
+	)), nil
+}
+
+func textNotIn(params []string) (Rule, error) {
+	if len(params) == 0 {
+		return nil, errors.New("expects at least 1 parameter")
+	}
+
+	return withKeywords("", map[string]any{"not": map[string]any{"enum": textEnum(params)}}, RuleFunc(
+		func(value any) error {
+			if slices.ContainsFunc(params, func(p string) bool { return textEqual(value, p) }) {
+				return notInError{Values: params}
+			}
+			return nil
+		},
+	)), nil
+}
+
+func textNEQ(params []string) (Rule, error) {
+	if len(params) != 1 {
+		return nil, fmt.Errorf("expects 1 parameter, got %d", len(params))
+	}
+
+	return withKeywords("", map[string]any{"not": map[string]any{"enum": textEnum(params)}}, RuleFunc(
+		func(value any) error {
+			if textEqual(value, params[0]) {
+				return neqError{Value: params[0]}
+			}
+			return nil
+		},
+	)), nil
+}
+
+// ruleSyntax describes the separators of a textual rule list.
+type ruleSyntax struct {
+	rules  rune   // between two rules
+	params string // between a rule name and its parameters
+	param  rune   // between two parameters
+}
+
+var (
+	// tagSyntax is used by `validate` struct tags: "required,max_length=255,in=a b c".
+	tagSyntax = ruleSyntax{rules: ',', params: "=", param: ' '}
+	// pipeSyntax is used by rule strings: "required|max_length:255|in:a,b,c".
+	pipeSyntax = ruleSyntax{rules: '|', params: ":", param: ','}
+)
+
+// parseRules parses a textual rule list into rules. Empty rules and empty parameters are ignored; single quotes protect
+// separators inside a parameter. Every problem is reported as a RuleSyntaxError.
+func (r *Registry) parseRules(spec string, syntax ruleSyntax) ([]Rule, error) {
+	items, err := splitUnquoted(spec, syntax.rules)
+	if err != nil {
+		return nil, RuleSyntaxError{Err: err}
+	}
+
+	var rules []Rule
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, rawParams, _ := strings.Cut(item, syntax.params)
+		name = strings.TrimSpace(name)
+
+		parts, err := splitUnquoted(rawParams, syntax.param)
+		if err != nil {
+			return nil, RuleSyntaxError{Rule: name, Err: err}
+		}
+
+		var params []string
+		for _, p := range parts {
+			if p = strings.TrimSpace(p); p != "" {
+				params = append(params, unquoteParam(p))
+			}
+		}
+
+		rule, err := r.Rule(name, params...)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// withField adds the field path to the cause of a RuleSyntaxError raised while building that field's rules.
+func withField(err error, path string) error {
+	var rse RuleSyntaxError
+	if !errors.As(err, &rse) {
+		return err
+	}
+
+	rse.Err = fmt.Errorf("field %q: %w", path, rse.Err)
+
+	return rse
+}
+
+// splitUnquoted splits s around every sep that is not inside single quotes. The quotes are kept in the returned parts;
+// use unquoteParam to remove them.
+func splitUnquoted(s string, sep rune) ([]string, error) {
+	var (
+		parts  []string
+		start  int
+		quoted bool
+	)
+
+	for i, c := range s {
+		switch {
+		case c == '\'':
+			quoted = !quoted
+		case c == sep && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + utf8.RuneLen(c)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote in %q", s)
+	}
+
+	return append(parts, s[start:]), nil
+}
+
+// unquoteParam removes the single quotes from a parameter. A doubled single quote inside a quoted section stands for a
+// literal quote.
+func unquoteParam(s string) string {
+	if !strings.Contains(s, "'") {
+		return s
+	}
+
+	var (
+		out    strings.Builder
+		quoted bool
+	)
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\'' && quoted && i+1 < len(s) && s[i+1] == '\'':
+			out.WriteByte('\'')
+			i++
+		case s[i] == '\'':
+			quoted = !quoted
+		default:
+			out.WriteByte(s[i])
+		}
+	}
+
+	return out.String()
+}
@@ -0,0 +1,254 @@
+package validation
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRegistryRule(t *testing.T) {
+	type Age int
+
+	tests := []struct {
+		name     string
+		params   []string
+		value    any
+		wantCode string
+	}{
+		{"required", nil, nil, "required"},
+		{"max_length", []string{"3"}, "abcd", "max_length"},
+		{"max_length", []string{"3"}, "abc", ""},
+		{"min", []string{"18"}, 17, "min"},
+		{"min", []string{"18"}, float64(18), ""},
+		{"min", []string{"18"}, Age(20), ""},
+		{"max", []string{"1.5"}, 2, "max"},
+		{"gt", []string{"0"}, 0, "gt"},
+		{"gte", []string{"0"}, 0, ""},
+		{"lt", []string{"10"}, uint8(10), "lt"},
+		{"lte", []string{"10"}, int64(10), ""},
+		{"between", []string{"1", "10"}, 11, "between"},
+		{"between", []string{"1", "10"}, float32(5), ""},
+		{"between", []string{"1", "10"}, "5", "between"},
+		{"multiple_of", []string{"3"}, 9, ""},
+		{"multiple_of", []string{"3"}, 10, "multiple_of"},
+		{"in", []string{"a", "b"}, "b", ""},
+		{"in", []string{"a", "b"}, "c", "in"},
+		{"in", []string{"1", "2"}, float64(2), ""},
+		{"in", []string{"true"}, true, ""},
+		{"not_in", []string{"a", "b"}, "a", "not_in"},
+		{"not_in", []string{"a", "b"}, "c", ""},
+		{"neq", []string{"0"}, 0, "neq"},
+		{"neq", []string{"0"}, 1, ""},
+		{"date_time_between", []string{"2024-01-01", "2024-12-31"}, "2024-06-01", ""},
+		{"after", []string{"2024-01-01"}, "2023-06-01", "after"},
+		{"digits_between", []string{"2", "3"}, "1234", "digits_between"},
+		{"regex", []string{"^a+$"}, "aaa", ""},
+	}
+	for _, tt := range tests {
+		r, err := NewRegistry().Rule(tt.name, tt.params...)
+		if err != nil {
+			t.Errorf("Rule(%q, %v) error = %v", tt.name, tt.params, err)
+			continue
+		}
+
+		err = r.Validate(tt.value)
+		if got := errorCode(err); got != tt.wantCode {
+			t.Errorf("%s%v.Validate(%v) code = %q, want %q", tt.name, tt.params, tt.value, got, tt.wantCode)
+		}
+	}
+}
+
+func TestRegistryRule_ParamsOfNumericErrors(t *testing.T) {
+	r, err := NewRegistry().Rule("min", "18")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, ok := r.Validate(1).(Error)
+	if !ok || e.Params()["value"] != 18 {
+		t.Errorf("expected int parameter in error params, got %v", e)
+	}
+}
+
+func TestRegistryRule_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []string
+	}{
+		{"unknown", nil},
+		{"required", []string{"x"}},
+		{"max_length", nil},
+		{"max_length", []string{"x"}},
+		{"between", []string{"1"}},
+		{"between", []string{"1", "x"}},
+		{"min", []string{"NaN"}},
+		{"multiple_of", []string{"0"}},
+		{"regex", []string{"["}},
+		{"after", []string{"not-a-date"}},
+		{"in", nil},
+		{"required_with", nil},
+		{"digits_between", []string{"1", "x"}},
+	}
+	for _, tt := range tests {
+		_, err := NewRegistry().Rule(tt.name, tt.params...)
+		var rse RuleSyntaxError
+		if !errors.As(err, &rse) || rse.Rule != tt.name {
+			t.Errorf("Rule(%q, %v) expected RuleSyntaxError, got %v", tt.name, tt.params, err)
+		}
+	}
+}
+
+func TestSplitUnquoted(t *testing.T) {
+	tests := []struct {
+		in   string
+		sep  rune
+		want []string
+	}{
+		{"a,b,c", ',', []string{"a", "b", "c"}},
+		{"a,'b,c',d", ',', []string{"a", "'b,c'", "d"}},
+		{"'it''s',x", ',', []string{"'it''s'", "x"}},
+		{"", ',', []string{""}},
+		{"1 100", ' ', []string{"1", "100"}},
+	}
+	for _, tt := range tests {
+		got, err := splitUnquoted(tt.in, tt.sep)
+		if err != nil {
+			t.Errorf("splitUnquoted(%q) error = %v", tt.in, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("splitUnquoted(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+
+	for in, want := range map[string]string{"plain": "plain", "'a,b'": "a,b", "'it''s'": "it's", "x'y z'": "xy z"} {
+		if got := unquoteParam(in); got != want {
+			t.Errorf("unquoteParam(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	if _, err := splitUnquoted("'abc", ','); err == nil {
+		t.Error("expected error for unterminated quote")
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	reg := NewRegistry()
+
+	sku := RuleFunc(
+		func(value any) error {
+			if s, ok := value.(string); !ok || !strings.HasPrefix(s, "SKU-") {
+				return errors.New("not a sku")
+			}
+			return nil
+		},
+	)
+	for name, factory := range map[string]RuleFactory{
+		"sku":          NoParams(sku),
+		"divisible_by": IntParam(func(n int) Rule { return MultipleOf[int](n) }),
+		"acme.prefix":  StringParam(StartsWith),
+		"one_of":       StringListParam(func(v ...string) Rule { return In(v) }),
+		"short_range":  IntPairParam(DigitsBetween),
+		"ratio_max":    FloatParam(func(f float64) Rule { return Max[float64](f) }),
+		"since":        TimeParam(After),
+		"window":       TimePairParam(DateTimeBetween),
+	} {
+		if err := reg.Register(name, factory); err != nil {
+			t.Fatalf("Register(%q) error = %v", name, err)
+		}
+	}
+
+	schema, err := reg.FromMap(
+		map[string]string{
+			"code":  "required|sku",
+			"qty":   "divisible_by:6",
+			"kind":  "one_of:a,b",
+			"ratio": "ratio_max:0.5",
+			"day":   "since:2024-01-01|window:2024-01-01,2024-12-31",
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := schema.Validate(
+		map[string]any{"code": "X-1", "qty": 7, "kind": "c", "ratio": 0.75, "day": "2025-06-01"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := pathCodes(res)
+	want := []string{"code:", "day:date_time_between", "kind:in", "qty:multiple_of", "ratio:max"}
+	if !slices.Equal(got, want) {
+		t.Errorf("errors = %v, want %v", got, want)
+	}
+
+	if _, err := ParseRules("sku"); err == nil {
+		t.Error("custom rules must not leak into the package-level functions")
+	}
+}
+
+func TestRegistry_RegisterOverride(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.Register("email", NoParams(Required)); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := reg.Rule("email")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Validate("not-an-email"); err != nil {
+		t.Errorf("expected overridden rule to be used, got %v", err)
+	}
+}
+
+func TestRegistry_RegisterInvalid(t *testing.T) {
+	reg := NewRegistry()
+
+	for _, name := range []string{"", "1abc", "with space", "a|b", "a:b", "a,b", "a=b"} {
+		if err := reg.Register(name, NoParams(Required)); err == nil {
+			t.Errorf("Register(%q) expected error", name)
+		}
+	}
+	if err := reg.Register("nil_factory", nil); err == nil {
+		t.Error("Register with nil factory expected error")
+	}
+}
+
+func TestRegistry_Names(t *testing.T) {
+	names := NewRegistry().Names()
+	if !slices.IsSorted(names) {
+		t.Error("Names() should be sorted")
+	}
+	for _, want := range []string{"required", "max_length", "date_time_format", "same_as"} {
+		if !slices.Contains(names, want) {
+			t.Errorf("Names() missing %q", want)
+		}
+	}
+}
+
+func TestRegistry_FromStruct(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.Register("even", NoParams(MultipleOf[int](2))); err != nil {
+		t.Fatal(err)
+	}
+
+	type Form struct {
+		N int `json:"n" validate:"even"`
+	}
+
+	schema, err := reg.FromStruct(Form{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := schema.Validate(Form{N: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.For("n")) != 1 {
+		t.Errorf("expected error for n, got %v", res.Errors())
+	}
+}
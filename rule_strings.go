@@ -0,0 +1,66 @@
+package validation
+
+import "sort"
+
+// ParseRules parses a pipe-delimited rule string such as "required|email|max_length:255|in:a,b,c" into rules that can
+// be passed to Schema.Field.
+//
+// Rules are separated by "|", a rule name is followed by ":" and comma-separated parameters. Rule names are the error
+// codes the rules report ("required", "min_length", "starts_with", "date_time_format", ...), the same names accepted by
+// FromStruct tags. Wrap a parameter in single quotes when it contains "|" or ",", e.g. "regex:'^(a|b)$'"; double a
+// quote to escape it. Conditions for required_if and required_unless should quote string literals with double quotes.
+//
+// Numeric rules (min, max, between, gt, ...) accept any numeric kind, so "between:1,100" works on the float64 values
+// produced by encoding/json.
+//
+// An unknown rule name, a wrong parameter count, or a malformed parameter is reported as a RuleSyntaxError.
+//
+// Examples:
+//
+//	rules, err := validation.ParseRules("required|email|max_length:255")
+//	rules, err := validation.ParseRules("in:draft,published|not_in:deleted")
+//	rules, err := validation.ParseRules(`required_if:plan == "paid"|regex:'^[A-Z]{2}\d+$'`)
+func ParseRules(spec string) ([]Rule, error) {
+	return defaultRegistry.ParseRules(spec)
+}
+
+// ParseRules parses a pipe-delimited rule string, resolving rule names through the registry. See the package-level
+// ParseRules for the syntax.
+func (r *Registry) ParseRules(spec string) ([]Rule, error) {
+	return r.parseRules(spec, pipeSyntax)
+}
+
+// FromMap builds a Schema from a map of field paths to pipe-delimited rule strings, e.g. loaded from configuration:
+//
+//	schema, err := validation.FromMap(map[string]string{
+//		"email":       "required|email|max_length:255",
+//		"age":         "between:18,130",
+//		"items.*.sku": "required|alpha_num",
+//	})
+//
+// Go maps are unordered, so fields are added in sorted path order to keep Result.Errors deterministic. Rule strings
+// follow ParseRules; a RuleSyntaxError names the offending field.
+func FromMap(fields map[string]string) (*Schema, error) {
+	return defaultRegistry.FromMap(fields)
+}
+
+// FromMap builds a Schema from a map of field paths to pipe-delimited rule strings, resolving rule names through the
+// registry. See the package-level FromMap for details.
+func (r *Registry) FromMap(fields map[string]string) (*Schema, error) {
+	paths := make([]string, 0, len(fields))
+	for path := range fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	s := New()
+	for _, path := range paths {
+		rules, err := r.ParseRules(fields[path])
+		if err != nil {
+			return nil, withField(err, path)
+		}
+		s.Field(path, rules...)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,129 @@
+package validation
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParseRules(t *testing.T) {
+	tests := []struct {
+		spec      string
+		value     any
+		wantCodes []string
+	}{
+		{"required|email|max_length:10", "a@example.com", []string{"max_length"}},
+		{"required|email", "nope", []string{"email"}},
+		{" required | min_length: 2 ", "a", []string{"min_length"}},
+		{"in:a,b,c", "b", nil},
+		{"in:a,b,c", "d", []string{"in"}},
+		{"in:'a,b',c", "a,b", nil},
+		{"regex:'^(a|b)$'", "a", nil},
+		{"regex:'^(a|b)$'", "c", []string{"regex"}},
+		{"between:1,100", float64(50), nil},
+		{"between:1,100", float64(500), []string{"between"}},
+		{"date_time_format:2006-01-02", "2024-02-30", []string{"date_time_format"}},
+		{"starts_with:'it''s'", "it's here", nil},
+		{"", "anything", nil},
+	}
+	for _, tt := range tests {
+		rules, err := ParseRules(tt.spec)
+		if err != nil {
+			t.Errorf("ParseRules(%q) error = %v", tt.spec, err)
+			continue
+		}
+
+		var codes []string
+		for _, r := range rules {
+			if code := errorCode(r.Validate(tt.value)); code != "" {
+				codes = append(codes, code)
+			}
+		}
+		if !slices.Equal(codes, tt.wantCodes) {
+			t.Errorf("ParseRules(%q) on %v codes = %v, want %v", tt.spec, tt.value, codes, tt.wantCodes)
+		}
+	}
+}
+
+func TestParseRules_Errors(t *testing.T) {
+	tests := []string{
+		"required|shiny",
+		"max_length",
+		"max_length:abc",
+		"between:1",
+		"regex:'[",
+		"regex:[",
+		"email:x",
+	}
+	for _, spec := range tests {
+		_, err := ParseRules(spec)
+		var rse RuleSyntaxError
+		if !errors.As(err, &rse) {
+			t.Errorf("ParseRules(%q) expected RuleSyntaxError, got %v", spec, err)
+		}
+	}
+}
+
+func TestFromMap(t *testing.T) {
+	schema, err := FromMap(
+		map[string]string{
+			"name":        "required|min_length:2",
+			"email":       "required|email",
+			"plan":        "in:free,paid",
+			"vat":         `required_if:plan == "paid"`,
+			"items.*.sku": "required",
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := schema.Validate(
+		map[string]any{
+			"name":  "A",
+			"plan":  "paid",
+			"items": []any{map[string]any{"sku": "x"}, map[string]any{}},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := pathCodes(res)
+	want := []string{"email:required", "items.1.sku:required", "name:min_length", "vat:required_if"}
+	if !slices.Equal(got, want) {
+		t.Errorf("errors = %v, want %v", got, want)
+	}
+}
+
+func TestFromMap_Bail(t *testing.T) {
+	schema, err := FromMap(
+		map[string]string{
+			"name": "required|bail|min_length:2|regex:^[a-z]+$",
+			"code": "min_length:2|regex:^[a-z]+$",
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := schema.Validate(map[string]any{"name": "A", "code": "A"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := pathCodes(res)
+	want := []string{"code:min_length", "code:regex", "name:min_length"}
+	if !slices.Equal(got, want) {
+		t.Errorf("errors = %v, want %v", got, want)
+	}
+}
+
+func TestFromMap_Error(t *testing.T) {
+	_, err := FromMap(map[string]string{"name": "required|nope"})
+
+	var rse RuleSyntaxError
+	if !errors.As(err, &rse) || rse.Rule != "nope" {
+		t.Errorf("expected RuleSyntaxError for rule nope, got %v", err)
+	}
+}
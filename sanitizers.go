@@ -0,0 +1,690 @@
+package validation
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Sanitizer transforms a field value before the rules run, e.g. trimming a string. Sanitizers receive nil for an
+// absent field and should return values of other types unchanged.
+type Sanitizer interface {
+	Sanitize(value any) any
+}
+
+// SanitizerFunc is a function adapter that implements the Sanitizer interface.
+type SanitizerFunc func(value any) any
+
+// Sanitize implements Sanitizer.
+func (f SanitizerFunc) Sanitize(value any) any { return f(value) }
+
+// stringSanitizer applies fn to string values, including those of named string types, which keep their type, and
+// returns any other value unchanged.
+func stringSanitizer(fn func(string) string) Sanitizer {
+	return SanitizerFunc(
+		func(value any) any {
+			if s, ok := value.(string); ok {
+				return fn(s)
+			}
+			if rv := reflect.ValueOf(value); rv.Kind() == reflect.String {
+				return reflect.ValueOf(fn(rv.String())).Convert(rv.Type()).Interface()
+			}
+			return value
+		},
+	)
+}
+
+// Trim removes leading and trailing white space from a string.
+//
+// Examples:
+//
+//	" User@Example.com " → "User@Example.com"
+var Trim = stringSanitizer(strings.TrimSpace)
+
+// ToLower maps a string to lower case.
+//
+// Examples:
+//
+//	"User@Example.com" → "user@example.com"
+var ToLower = stringSanitizer(strings.ToLower)
+
+// CollapseSpaces replaces every run of white space in a string with a single space. It does not trim; combine it with
+// Trim for that.
+//
+// Examples:
+//
+//	"Jane \t  Doe" → "Jane Doe"
+var CollapseSpaces = stringSanitizer(collapseSpaces)
+
+// FoldUnicode maps a fixed set of character variants that commonly make otherwise valid input fail to their plain
+// forms:
+//   - full-width ASCII forms, as typed with CJK input methods, become ASCII ("Ａ１" → "A1")
+//   - non-breaking and other Unicode spaces become a plain space
+//   - zero-width spaces, word joiners, soft hyphens, and byte order marks are removed
+//   - Persian and Arabic-Indic digits become ASCII digits ("۱۲۳" → "123")
+//
+// It is not Unicode normalization. For NFC or NFKC, which need tables outside the standard library, wrap
+// golang.org/x/text/unicode/norm:
+//
+//	nfkc := validation.SanitizerFunc(func(v any) any {
+//		if s, ok := v.(string); ok {
+//			return norm.NFKC.String(s)
+//		}
+//		return v
+//	})
+var FoldUnicode = stringSanitizer(foldUnicode)
+
+// Default returns a Sanitizer that replaces an absent or nil value with v.
+//
+// Examples:
+//
+//	Field("role", validation.In([]string{"member", "admin"})).Sanitize(validation.Default("member"))
+func Default(v any) Sanitizer {
+	return SanitizerFunc(
+		func(value any) any {
+			if value == nil {
+				return v
+			}
+			return value
+		},
+	)
+}
+
+func collapseSpaces(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	inSpace := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			if !inSpace {
+				b.WriteByte(' ')
+			}
+			inSpace = true
+			continue
+		}
+		inSpace = false
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+func foldUnicode(s string) string {
+	return strings.Map(
+		func(r rune) rune {
+			switch {
+			case r >= '\uff01' && r <= '\uff5e': // full-width ! to ~
+				return r - '\uff01' + '!'
+			case r == '\u200b' || r == '\u2060' || r == '\u00ad' || r == '\ufeff':
+				return -1
+			case unicode.Is(unicode.Zs, r):
+				return ' '
+			case r >= '۰' && r <= '۹':
+				return r - '۰' + '0'
+			case r >= '٠' && r <= '٩':
+				return r - '٠' + '0'
+			default:
+				return r
+			}
+		}, s,
+	)
+}
+
+// Sanitize sets the sanitizers of the most recently added field. They run in order on the field's value, at every
+// match of a wildcard path, before any rule of the schema runs, so the rules see the cleaned value:
+//
+//	schema := validation.New().
+//		Field("email", validation.Required, validation.Email).Sanitize(validation.Trim, validation.ToLower).
+//		Field("role", validation.In([]string{"member", "admin"})).Sanitize(validation.Default("member"))
+//
+// Validate sanitizes a copy of the input and leaves the input untouched; use Process to get the cleaned copy. The copy
+// keeps the input's types, replacing only the maps, slices, structs, and pointers that hold a changed value, so rules
+// of other fields that assert a type still see the values they were given. In partial validation absent fields stay
+// absent, so Default does not apply to them.
+//
+// Sanitize has no effect on an empty schema. It returns the receiver to support chaining.
+func (s *Schema) Sanitize(sanitizers ...Sanitizer) *Schema {
+	if len(s.fields) > 0 {
+		f := &s.fields[len(s.fields)-1]
+		f.sanitizers = append(f.sanitizers, sanitizers...)
+	}
+
+	return s
+}
+
+// Process sanitizes a copy of the input, validates the copy, and returns it with the result.
+//
+// The copy is made of plain values: maps with string keys and structs become map[string]any, with struct fields keyed
+// by their json name as in paths, and slices and arrays become []any. cleaned is nil when the input is not an object.
+// Nested sub-schemas sanitize their part of the copy too, including those under Each and the logical combinators.
+//
+// Example:
+//
+//	cleaned, res, err := schema.Process(map[string]any{"email": " User@Example.com "})
+//	// cleaned["email"] == "user@example.com"
+func (s *Schema) Process(input any) (cleaned map[string]any, res *Result, err error) {
+	return s.ProcessContext(context.Background(), input)
+}
+
+// ProcessContext is Process with a context, as ValidateContext is to Validate.
+func (s *Schema) ProcessContext(ctx context.Context, input any) (cleaned map[string]any, res *Result, err error) {
+	clean := cloneInput(input)
+	s.sanitize(clean, false, "", nil)
+
+	res, err = s.validate(ctx, clean, validateOptions{sanitized: true})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleaned, _ = plainCopy(clean).(map[string]any)
+
+	return cleaned, res, nil
+}
+
+func (s *Schema) hasSanitizers() bool {
+	for _, f := range s.fields {
+		if len(f.sanitizers) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sanitizedInput returns input with the sanitizers of s, and of the sub-schemas its rules reach, applied. Only the
+// maps, slices, structs, and pointers on the paths of changed values are copied, keeping their types, so the rules of
+// other fields see their values as passed in. When a changed value does not fit the type that holds it, the input is
+// returned as Process copies it instead.
+func (s *Schema) sanitizedInput(input any, partial bool) any {
+	clean := cloneInput(input)
+
+	out, typed := input, typedCopy{owned: make(map[uintptr]bool)}
+	ok := true
+	s.sanitize(
+		clean, partial, "", func(path string, value any) {
+			if ok {
+				out, ok = typed.set(out, strings.Split(path, "."), value)
+			}
+		},
+	)
+	if !ok {
+		return clean
+	}
+
+	return out
+}
+
+// sanitize runs the sanitizers of every field, and of the sub-schemas its rules reach, on input in place. The input
+// must be a copy made by cloneInput. In partial mode absent fields are left alone. Each value a sanitizer changes is
+// also passed to write, unless write is nil, with its path below prefix.
+func (s *Schema) sanitize(input any, partial bool, prefix string, write func(path string, value any)) {
+	bag := NewInputBag(input)
+	for _, f := range s.fields {
+		if len(f.sanitizers) == 0 && len(subSchemas(f.rules)) == 0 {
+			continue
+		}
+
+		for _, path := range bag.expand(f.path) {
+			value, found := bag.Lookup(path)
+			if !found && partial {
+				continue
+			}
+
+			sanitized := value
+			for _, sanitizer := range f.sanitizers {
+				sanitized = sanitizer.Sanitize(sanitized)
+			}
+			if (found || sanitized != nil) && !sameValue(value, sanitized) {
+				if setPath(input, path, sanitized) && write != nil {
+					write(joinPath(prefix, path), sanitized)
+				}
+			}
+
+			sanitizeSubSchemas(f.rules, sanitized, partial, joinPath(prefix, path), write)
+		}
+	}
+}
+
+// sanitizeSubSchemas sanitizes value, found at path, with the Nested sub-schemas that rules apply to it, directly or
+// through combinators such as When and Any, and each element of value with those applied by Each.
+func sanitizeSubSchemas(rules []Rule, value any, partial bool, path string, write func(path string, value any)) {
+	for _, r := range rules {
+		d, ok := asDescribed(r)
+		if !ok {
+			continue
+		}
+
+		if d.schema != nil {
+			d.schema.sanitize(value, partial, path, write)
+		}
+		sanitizeSubSchemas(d.inner, value, partial, path, write)
+		if elems, ok := value.([]any); ok && len(d.items) > 0 {
+			for i, elem := range elems {
+				sanitizeSubSchemas(d.items, elem, partial, joinPath(path, strconv.Itoa(i)), write)
+			}
+		}
+	}
+}
+
+// sameValue reports whether a sanitizer returned its argument unchanged. Maps, slices, and pointers are compared by
+// identity.
+func sameValue(a, b any) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if ca, ok := a.(structCopy); ok {
+		cb, ok := b.(structCopy)
+		return ok && reflect.ValueOf(ca.fields).Pointer() == reflect.ValueOf(cb.fields).Pointer()
+	}
+
+	ra, rb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if ra.Type() != rb.Type() {
+		return false
+	}
+	switch ra.Kind() {
+	case reflect.Map, reflect.Pointer, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return ra.Pointer() == rb.Pointer()
+	case reflect.Slice:
+		return ra.Pointer() == rb.Pointer() && ra.Len() == rb.Len()
+	default:
+		return ra.Comparable() && a == b
+	}
+}
+
+// subSchemas returns the Nested sub-schemas that sanitizeSubSchemas reaches from rules.
+func subSchemas(rules []Rule) []*Schema {
+	var schemas []*Schema
+	for _, r := range rules {
+		d, ok := asDescribed(r)
+		if !ok {
+			continue
+		}
+
+		if d.schema != nil {
+			schemas = append(schemas, d.schema)
+		}
+		schemas = append(schemas, subSchemas(d.inner)...)
+		schemas = append(schemas, subSchemas(d.items)...)
+	}
+
+	return schemas
+}
+
+// sanitizedSchemas returns the sub-schemas that sanitize runs along with the fields of s. Their values are sanitized
+// once s's input is, so Nested does not sanitize them again; sub-schemas used by other rules sanitize their own value.
+func (s *Schema) sanitizedSchemas() map[*Schema]bool {
+	set := make(map[*Schema]bool)
+	for _, f := range s.fields {
+		for _, schema := range subSchemas(f.rules) {
+			set[schema] = true
+		}
+	}
+
+	return set
+}
+
+// setPath stores value at a dot-notation path of a copy made by cloneInput, creating missing intermediate objects.
+// It reports whether the path leads to a place for the value.
+func setPath(root any, path string, value any) bool {
+	segments := strings.Split(path, ".")
+	parent := root
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := step(parent, segment)
+		if !ok || next == nil {
+			m, isMap := parent.(map[string]any)
+			if !isMap {
+				return false
+			}
+			next = make(map[string]any)
+			m[segment] = next
+		}
+		parent = next
+	}
+
+	last := segments[len(segments)-1]
+	switch p := parent.(type) {
+	case map[string]any:
+		p[last] = value
+		return true
+	case structCopy:
+		key, ok := p.key(last)
+		if ok {
+			p.fields[key] = value
+		}
+		return ok
+	case []any:
+		i, ok := sliceIndex(last, len(p))
+		if ok {
+			p[i] = value
+		}
+		return ok
+	default:
+		return false
+	}
+}
+
+// typedCopy stores sanitized values into a copy of the input that keeps the input's types. It copies the maps,
+// slices, structs, and pointers on the path of each value it stores, once: owned holds the maps, slices, and pointers
+// it made, which later values are stored into in place.
+type typedCopy struct {
+	owned map[uintptr]bool
+}
+
+// set returns value with v stored at the path segments, or false when a container on the path cannot hold what is
+// stored into it. A struct without the named field is returned unchanged.
+func (c typedCopy) set(value any, segments []string, v any) (any, bool) {
+	if len(segments) == 0 {
+		return v, true
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return value, false
+		}
+		elem, ok := c.set(rv.Elem().Interface(), segments, v)
+		ev, fits := convertTo(elem, rv.Type().Elem())
+		if !ok || !fits {
+			return value, false
+		}
+
+		p := rv
+		if !c.owned[rv.Pointer()] {
+			p = reflect.New(rv.Type().Elem())
+			c.owned[p.Pointer()] = true
+		}
+		p.Elem().Set(ev)
+		return p.Interface(), true
+	case reflect.Map:
+		return c.setMapIndex(rv, segments, v)
+	case reflect.Slice, reflect.Array:
+		i, ok := sliceIndex(segments[0], rv.Len())
+		if !ok {
+			return value, false
+		}
+		elem, ok := c.set(rv.Index(i).Interface(), segments[1:], v)
+		ev, fits := convertTo(elem, rv.Type().Elem())
+		if !ok || !fits {
+			return value, false
+		}
+
+		out := rv
+		switch {
+		case rv.Kind() == reflect.Array:
+			out = reflect.New(rv.Type()).Elem()
+			out.Set(rv)
+		case !c.owned[rv.Pointer()]:
+			out = reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+			reflect.Copy(out, rv)
+			c.owned[out.Pointer()] = true
+		}
+		out.Index(i).Set(ev)
+		return out.Interface(), true
+	case reflect.Struct:
+		out := reflect.New(rv.Type()).Elem()
+		out.Set(rv)
+		if _, ok := c.setField(out, segments, v); !ok {
+			return value, false
+		}
+		return out.Interface(), true
+	default:
+		return value, false
+	}
+}
+
+// setMapIndex stores v at the path segments below the string-keyed map rv, creating a missing intermediate object
+// as setPath does.
+func (c typedCopy) setMapIndex(rv reflect.Value, segments []string, v any) (any, bool) {
+	rt := rv.Type()
+	if rt.Key().Kind() != reflect.String {
+		return rv.Interface(), false
+	}
+
+	key := reflect.ValueOf(segments[0]).Convert(rt.Key())
+	var elem any
+	if e := rv.MapIndex(key); e.IsValid() {
+		elem = e.Interface()
+	}
+	if elem == nil && len(segments) > 1 {
+		elem = map[string]any{}
+	}
+	elem, ok := c.set(elem, segments[1:], v)
+	ev, fits := convertTo(elem, rt.Elem())
+	if !ok || !fits {
+		return rv.Interface(), false
+	}
+
+	out := rv
+	if rv.IsNil() || !c.owned[rv.Pointer()] {
+		out = reflect.MakeMapWithSize(rt, rv.Len()+1)
+		for iter := rv.MapRange(); iter.Next(); {
+			out.SetMapIndex(iter.Key(), iter.Value())
+		}
+		c.owned[out.Pointer()] = true
+	}
+	out.SetMapIndex(key, ev)
+
+	return out.Interface(), true
+}
+
+// setField stores v at the path segments below the addressable struct sv, resolving the field as structField does.
+// It reports whether the field was found, and false for ok when it cannot be set.
+func (c typedCopy) setField(sv reflect.Value, segments []string, v any) (found, ok bool) {
+	rt := sv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		fv := sv.Field(i)
+		if field.Anonymous {
+			embedded := fv
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					continue
+				}
+				embedded = reflect.New(fv.Type().Elem()).Elem()
+				embedded.Set(fv.Elem())
+			}
+			if embedded.Kind() != reflect.Struct {
+				continue
+			}
+
+			found, ok := c.setField(embedded, segments, v)
+			if !found {
+				continue
+			}
+			if ok && fv.Kind() == reflect.Pointer {
+				if !fv.CanSet() {
+					return true, false
+				}
+				fv.Set(embedded.Addr())
+			}
+			return true, ok
+		}
+		if !field.IsExported() || !fieldMatches(field, segments[0]) {
+			continue
+		}
+
+		elem, ok := c.set(fv.Interface(), segments[1:], v)
+		ev, fits := convertTo(elem, fv.Type())
+		if !ok || !fits || !fv.CanSet() {
+			return true, false
+		}
+		fv.Set(ev)
+		return true, true
+	}
+
+	return false, true
+}
+
+// convertTo returns v as a value of type t: v itself when it is assignable, converted when it is of a type with the
+// same kind such as a named string type, or a pointer to it when t is a pointer type.
+func convertTo(v any, t reflect.Type) (reflect.Value, bool) {
+	if v == nil {
+		switch t.Kind() {
+		case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice:
+			return reflect.Zero(t), true
+		default:
+			return reflect.Value{}, false
+		}
+	}
+
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Type().AssignableTo(t):
+		return rv, true
+	case rv.Kind() == t.Kind() && rv.Type().ConvertibleTo(t):
+		return rv.Convert(t), true
+	case t.Kind() == reflect.Pointer:
+		elem, ok := convertTo(v, t.Elem())
+		if !ok {
+			return reflect.Value{}, false
+		}
+		p := reflect.New(t.Elem())
+		p.Elem().Set(elem)
+		return p, true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// cloneInput returns a deep copy of value that sanitizers can modify in place: maps with string keys become
+// map[string]any, structs with exported fields become a structCopy, slices and arrays other than byte slices become
+// []any, and pointers are dereferenced. Other values, including structs such as time.Time, are kept as they are.
+func cloneInput(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			out[k] = cloneInput(e)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, e := range v {
+			out[i] = cloneInput(e)
+		}
+		return out
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return cloneInput(rv.Elem().Interface())
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return value
+		}
+		out := make(map[string]any, rv.Len())
+		for iter := rv.MapRange(); iter.Next(); {
+			out[iter.Key().String()] = cloneInput(iter.Value().Interface())
+		}
+		return out
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return value
+		}
+		out := make([]any, rv.Len())
+		for i := range out {
+			out[i] = cloneInput(rv.Index(i).Interface())
+		}
+		return out
+	case reflect.Struct:
+		if !hasExportedFields(rv.Type()) {
+			return value
+		}
+		out := structCopy{fields: make(map[string]any), names: make(map[string]string)}
+		cloneStruct(rv, out)
+		return out
+	default:
+		return value
+	}
+}
+
+// structCopy is the copy cloneInput makes of a struct. It holds the fields by json name, as Process returns them, and
+// maps their Go names to those, so a path resolves against the copy as structField resolves it against the struct.
+type structCopy struct {
+	fields map[string]any
+	names  map[string]string // Go name → json name, for fields whose json name differs
+}
+
+// key returns the key of the field that a path segment names.
+func (c structCopy) key(name string) (string, bool) {
+	if _, ok := c.fields[name]; ok {
+		return name, true
+	}
+	key, ok := c.names[name]
+
+	return key, ok
+}
+
+// cloneStruct copies the exported fields of a struct into out, with the fields of embedded structs promoted as
+// structField resolves them.
+func cloneStruct(rv reflect.Value, out structCopy) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		fv := rv.Field(i)
+		if field.Anonymous {
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				cloneStruct(fv, out)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+		if _, exists := out.fields[name]; !exists {
+			out.fields[name] = cloneInput(fv.Interface())
+		}
+		if _, exists := out.names[field.Name]; !exists && field.Name != name {
+			out.names[field.Name] = name
+		}
+	}
+}
+
+// plainCopy turns the structCopy values of a copy made by cloneInput into map[string]any, in place where it can.
+func plainCopy(value any) any {
+	switch v := value.(type) {
+	case structCopy:
+		return plainCopy(v.fields)
+	case map[string]any:
+		for k, e := range v {
+			v[k] = plainCopy(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = plainCopy(e)
+		}
+	}
+
+	return value
+}
+
+func hasExportedFields(rt reflect.Type) bool {
+	for i := 0; i < rt.NumField(); i++ {
+		if rt.Field(i).IsExported() || rt.Field(i).Anonymous {
+			return true
+		}
+	}
+
+	return false
+}
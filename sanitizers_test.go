@@ -0,0 +1,311 @@
+package validation
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSanitizers(t *testing.T) {
+	type email string
+
+	tests := []struct {
+		name      string
+		sanitizer Sanitizer
+		value     any
+		want      any
+	}{
+		{"trim", Trim, " \tUser@Example.com \n", "User@Example.com"},
+		{"to lower", ToLower, "User@Example.COM", "user@example.com"},
+		{"collapse spaces", CollapseSpaces, " Jane \t  Doe ", " Jane Doe "},
+		{"fold full width", FoldUnicode, "Ａｂｃ１２３！", "Abc123!"},
+		{"fold spaces", FoldUnicode, "a\u00a0b\u3000c", "a b c"},
+		{"fold zero width", FoldUnicode, "\ufeffab\u200bc\u00ad", "abc"},
+		{"fold digits", FoldUnicode, "۰۹۱۲٣٤", "091234"},
+		{"keeps zero-width non-joiner", FoldUnicode, "می\u200cروم", "می\u200cروم"},
+		{"named string", Trim, email(" a@b.co "), email("a@b.co")},
+		{"named string lower", ToLower, email("A@B.co"), email("a@b.co")},
+		{"non-string", Trim, 42, 42},
+		{"nil", ToLower, nil, nil},
+		{"default on nil", Default("member"), nil, "member"},
+		{"default keeps value", Default("member"), "admin", "admin"},
+		{"default keeps zero", Default(10), 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := tt.sanitizer.Sanitize(tt.value); got != tt.want {
+					t.Errorf("Sanitize(%q) = %q, want %q", tt.value, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestSchemaProcess(t *testing.T) {
+	address := New().
+		Field("city", Required, MinLength(2)).Sanitize(Trim, CollapseSpaces)
+
+	upper := SanitizerFunc(
+		func(v any) any {
+			if s, ok := v.(string); ok {
+				return s + "!"
+			}
+			return v
+		},
+	)
+
+	schema := New().
+		Field("email", Required, Email).Sanitize(Trim, ToLower).
+		Field("role", In([]string{"member", "admin"})).Sanitize(Default("member")).
+		Field("profile.country", Required).Sanitize(Default("NL")).
+		Field("tags.*", Slug).Sanitize(Trim, ToLower).
+		Field("address", Nested(address)).
+		Field("note", Required).Sanitize(upper)
+
+	input := map[string]any{
+		"email":   " User@Example.com ",
+		"tags":    []any{" Go ", "JSON"},
+		"address": map[string]any{"city": "  New   York "},
+		"note":    "hi",
+		"extra":   []string{"kept"},
+	}
+
+	cleaned, res, err := schema.Process(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+
+	want := map[string]any{
+		"email":   "user@example.com",
+		"role":    "member",
+		"profile": map[string]any{"country": "NL"},
+		"tags":    []any{"go", "json"},
+		"address": map[string]any{"city": "New York"},
+		"note":    "hi!",
+		"extra":   []any{"kept"},
+	}
+	if !reflect.DeepEqual(cleaned, want) {
+		t.Errorf("cleaned = %#v\nwant %#v", cleaned, want)
+	}
+	if input["email"] != " User@Example.com " || input["tags"].([]any)[0] != " Go " {
+		t.Error("Process modified the input")
+	}
+
+	// Validate sanitizes a copy the same way.
+	res, err = schema.Validate(map[string]any{"email": " A@B.CO ", "note": "x", "address": map[string]any{"city": " X "}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := pathCodes(res)
+	if want := []string{"address.city:min_length"}; !slices.Equal(got, want) {
+		t.Errorf("errors = %v, want %v", got, want)
+	}
+}
+
+func TestSchemaProcess_Struct(t *testing.T) {
+	type base struct {
+		ID int `json:"id"`
+	}
+	type user struct {
+		base
+		Name     string    `json:"name"`
+		Nick     *string   `json:"nick"`
+		Born     time.Time `json:"born"`
+		Secret   string    `json:"-"`
+		internal string
+	}
+
+	born := time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC)
+	nick := " Al "
+	schema := New().
+		Field("name", Required).Sanitize(Trim).
+		Field("nick", MaxLength(2)).Sanitize(Trim)
+
+	cleaned, res, err := schema.Process(&user{base: base{ID: 7}, Name: " Ann ", Nick: &nick, Born: born, internal: "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+
+	want := map[string]any{"id": 7, "name": "Ann", "nick": "Al", "born": born}
+	if !reflect.DeepEqual(cleaned, want) {
+		t.Errorf("cleaned = %#v\nwant %#v", cleaned, want)
+	}
+
+	if cleaned, _, _ := schema.Process("not an object"); cleaned != nil {
+		t.Errorf("cleaned = %v, want nil for a non-object input", cleaned)
+	}
+}
+
+func TestSchemaValidatePartial_Sanitize(t *testing.T) {
+	schema := New().
+		Field("role", Required, In([]string{"member"})).Sanitize(Default("member")).
+		Field("name", MinLength(2)).Sanitize(Trim)
+
+	res, err := schema.ValidatePartial(map[string]any{"name": " A "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.Errors(); len(got) != 1 || got[0].Path != "name" {
+		t.Errorf("errors = %v, want only name:min_length", got)
+	}
+}
+
+func TestSchemaProcess_EachNested(t *testing.T) {
+	item := New().Field("email", Email).Sanitize(Trim)
+	input := map[string]any{
+		"name":  " Ann ",
+		"items": []any{map[string]any{"email": " a@b.co "}},
+		"any":   map[string]any{"email": " c@d.co "},
+	}
+
+	schemas := map[string]*Schema{
+		"child sanitizers only": New().
+			Field("items", Each(Nested(item))).
+			Field("any", When("exists(name)", Not(Nested(item)))),
+		"parent sanitizers too": New().
+			Field("name", Required).Sanitize(Trim).
+			Field("items", Each(Nested(item))).
+			Field("any", When("exists(name)", Not(Nested(item)))),
+	}
+	for name, schema := range schemas {
+		res, err := schema.Validate(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := pathCodes(res), []string{"any:not"}; !slices.Equal(got, want) {
+			t.Errorf("%s: Validate errors = %v, want %v", name, got, want)
+		}
+
+		cleaned, _, err := schema.Process(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		items := cleaned["items"].([]any)
+		if got := items[0].(map[string]any)["email"]; got != "a@b.co" {
+			t.Errorf("%s: cleaned items.0.email = %q, want %q", name, got, "a@b.co")
+		}
+		if got := cleaned["any"].(map[string]any)["email"]; got != "c@d.co" {
+			t.Errorf("%s: cleaned any.email = %q, want %q", name, got, "c@d.co")
+		}
+	}
+}
+
+func TestSchemaValidate_StructGoNamesWithSanitizers(t *testing.T) {
+	type address struct {
+		City string `json:"city"`
+	}
+	type user struct {
+		Email   string  `json:"email"`
+		Address address `json:"address"`
+	}
+
+	input := user{Email: " a@b.co ", Address: address{City: " Paris "}}
+	plain := New().
+		Field("Email", Required).
+		Field("Address.City", Required)
+	sanitized := New().
+		Field("Email", Required, Email).Sanitize(Trim).
+		Field("Address.City", Required, MaxLength(5)).Sanitize(Trim)
+
+	for name, schema := range map[string]*Schema{"plain": plain, "sanitized": sanitized} {
+		res, err := schema.Validate(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.HasErrors() {
+			t.Errorf("%s: unexpected errors %v", name, pathCodes(res))
+		}
+	}
+
+	cleaned, _, err := sanitized.Process(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{"email": "a@b.co", "address": map[string]any{"city": "Paris"}}
+	if !reflect.DeepEqual(cleaned, want) {
+		t.Errorf("cleaned = %#v\nwant %#v", cleaned, want)
+	}
+}
+
+func TestSchemaValidate_SanitizeKeepsTypes(t *testing.T) {
+	type money struct {
+		Cents    int64
+		Currency string
+	}
+	type nullString struct {
+		String string
+		Valid  bool
+	}
+	type line struct {
+		SKU  string `json:"sku"`
+		Note string `json:"note"`
+	}
+	type order struct {
+		Name  string            `json:"name"`
+		Nick  *string           `json:"nick"`
+		Price money             `json:"price"`
+		Tags  []string          `json:"tags"`
+		Memo  nullString        `json:"memo"`
+		Lines []line            `json:"lines"`
+		Attrs map[string]string `json:"attrs"`
+		Label string            `json:"label"`
+	}
+
+	isType := func(want any) Rule {
+		return RuleFunc(
+			func(value any) error {
+				if reflect.TypeOf(value) != reflect.TypeOf(want) {
+					return basicError{"type", "type validation failed"}
+				}
+				return nil
+			},
+		)
+	}
+
+	nick := " Al "
+	input := &order{
+		Name:  " Ann ",
+		Nick:  &nick,
+		Price: money{Cents: 100, Currency: "EUR"},
+		Tags:  []string{"a", "b"},
+		Memo:  nullString{String: "x", Valid: true},
+		Lines: []line{{SKU: "A", Note: " n "}, {SKU: "B"}},
+		Attrs: map[string]string{"color": " red "},
+		Label: "not an object",
+	}
+	schema := New().
+		Field("name", Required, MaxLength(3)).Sanitize(Trim).
+		Field("nick", MaxLength(2)).Sanitize(Trim).
+		Field("price", isType(money{})).
+		Field("tags", isType([]string{})).
+		Field("memo", isType(nullString{})).
+		Field("lines", isType([]line{})).
+		Field("lines.*.note", MaxLength(1)).Sanitize(Trim).
+		Field("attrs", isType(map[string]string{})).
+		Field("attrs.color", In([]string{"red"})).Sanitize(Trim).
+		Field("attrs.size", Required).Sanitize(Default("M")).
+		Field("label", Nested(New().Field("x", MaxLength(5)).Sanitize(Default("d"))))
+
+	res, err := schema.Validate(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("unexpected errors %v", pathCodes(res))
+	}
+
+	if input.Name != " Ann " || *input.Nick != " Al " || input.Lines[0].Note != " n " || input.Attrs["color"] != " red " {
+		t.Errorf("Validate modified the input: %+v", input)
+	}
+	if _, ok := input.Attrs["size"]; ok {
+		t.Error("Validate added attrs.size to the input")
+	}
+}
@@ -0,0 +1,242 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// LoadSchema compiles a JSON schema definition document into a Schema, so validation for configurable forms can change
+// without a redeploy. Rule names resolve through the built-in rules; use Registry.LoadSchema for custom rules.
+//
+// The document lists fields in order, each with a path and a list of rules:
+//
+//	{
+//	  "fields": [
+//	    {"path": "email", "rules": ["required|email", {"rule": "max_length", "params": [255]}]},
+//	    {"path": "vat", "rules": [{"rule": "required_if", "params": ["country == \"DE\""]}]},
+//	    {"path": "phone", "rules": [{"when": "contact == \"phone\"", "rules": ["required", "phone_e164"]}]},
+//	    {"path": "nickname", "rules": [{"unless": "exists(name)", "rules": ["required"]}]},
+//	    {"path": "contact", "rules": [{"any": ["email", "phone_e164"]}]},
+//	    {"path": "username", "rules": [{"not": "in:admin,root"}]},
+//	    {"path": "tags", "rules": [{"each": ["slug", "max_length:20"]}]},
+//	    {"path": "address", "rules": [{"schema": {"fields": [{"path": "city", "rules": ["required"]}]}}]}
+//	  ]
+//	}
+//
+// A rule entry is one of:
+//   - a string in ParseRules syntax, holding one or more rules ("required|email|max_length:255");
+//   - {"rule": name, "params": [...]}, where params are strings, numbers, or booleans;
+//   - {"when": condition, "rules": [...]} or {"unless": condition, "rules": [...]}, compiled to When / Unless;
+//   - {"any": [...]}, {"not": entry}, {"each": [...]}, compiled to Any / Not / Each;
+//   - {"schema": document}, compiled to Nested with a sub-schema of the same shape.
+//
+// Conditions use the RequiredIf condition language. A when or unless entry holding "required" makes the field required
+// while its condition selects the rules, so above an absent phone fails when contact is "phone".
+//
+// Malformed JSON, unknown keys, unknown or misconfigured rules, and malformed conditions are reported as errors; rule
+// problems are RuleSyntaxErrors naming the offending field.
+func LoadSchema(r io.Reader) (*Schema, error) {
+	return defaultRegistry.LoadSchema(r)
+}
+
+// LoadSchema compiles a JSON schema definition document, resolving rule names through the registry. See the
+// package-level LoadSchema for the document format.
+func (r *Registry) LoadSchema(reader io.Reader) (*Schema, error) {
+	dec := json.NewDecoder(reader)
+	dec.DisallowUnknownFields()
+
+	var doc schemaDocument
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("validation: decode schema document: %w", err)
+	}
+
+	return r.compileDocument(doc)
+}
+
+type schemaDocument struct {
+	Fields []fieldDocument `json:"fields"`
+}
+
+type fieldDocument struct {
+	Path  string            `json:"path"`
+	Rules []json.RawMessage `json:"rules"`
+}
+
+type ruleDocument struct {
+	Rule   string            `json:"rule"`
+	Params []json.RawMessage `json:"params"`
+	When   *string           `json:"when"`
+	Unless *string           `json:"unless"`
+	Rules  []json.RawMessage `json:"rules"`
+	Any    []json.RawMessage `json:"any"`
+	Not    json.RawMessage   `json:"not"`
+	Each   []json.RawMessage `json:"each"`
+	Schema *schemaDocument   `json:"schema"`
+}
+
+func (r *Registry) compileDocument(doc schemaDocument) (*Schema, error) {
+	s := New()
+	for i, f := range doc.Fields {
+		if f.Path == "" {
+			return nil, fmt.Errorf("validation: field %d has no path", i)
+		}
+
+		rules, err := r.compileRuleList(f.Rules)
+		if err != nil {
+			return nil, withField(err, f.Path)
+		}
+		s.Field(f.Path, rules...)
+	}
+
+	return s, nil
+}
+
+func (r *Registry) compileRuleList(entries []json.RawMessage) ([]Rule, error) {
+	var rules []Rule
+	for _, entry := range entries {
+		compiled, err := r.compileRuleEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, compiled...)
+	}
+
+	return rules, nil
+}
+
+// compileRuleEntry compiles a single rule entry. String entries may hold several pipe-separated rules, so the result
+// is a slice.
+func (r *Registry) compileRuleEntry(entry json.RawMessage) ([]Rule, error) {
+	var spec string
+	if err := json.Unmarshal(entry, &spec); err == nil {
+		return r.ParseRules(spec)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(entry))
+	dec.UseNumber()
+	dec.DisallowUnknownFields()
+
+	var doc ruleDocument
+	if err := dec.Decode(&doc); err != nil {
+		return nil, RuleSyntaxError{Err: fmt.Errorf("invalid rule entry %s: %w", entry, err)}
+	}
+
+	if n := doc.kinds(); n != 1 {
+		return nil, RuleSyntaxError{
+			Err: fmt.Errorf("rule entry %s must have exactly one of rule, when, unless, any, not, each, schema", entry),
+		}
+	}
+	if doc.Rules != nil && doc.When == nil && doc.Unless == nil {
+		return nil, RuleSyntaxError{Err: fmt.Errorf("rule entry %s: rules is only valid with when or unless", entry)}
+	}
+	if doc.Params != nil && doc.Rule == "" {
+		return nil, RuleSyntaxError{Err: fmt.Errorf("rule entry %s: params is only valid with rule", entry)}
+	}
+
+	rule, err := r.compileRuleDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Rule{rule}, nil
+}
+
+func (r *Registry) compileRuleDocument(doc ruleDocument) (Rule, error) {
+	switch {
+	case doc.Rule != "":
+		params := make([]string, 0, len(doc.Params))
+		for _, raw := range doc.Params {
+			p, err := documentParam(raw)
+			if err != nil {
+				return nil, RuleSyntaxError{Rule: doc.Rule, Err: err}
+			}
+			params = append(params, p)
+		}
+		return r.Rule(doc.Rule, params...)
+	case doc.When != nil:
+		if err := checkCondition(*doc.When); err != nil {
+			return nil, RuleSyntaxError{Rule: "when", Err: err}
+		}
+		rules, err := r.compileRuleList(doc.Rules)
+		if err != nil {
+			return nil, err
+		}
+		return When(*doc.When, rules...), nil
+	case doc.Unless != nil:
+		if err := checkCondition(*doc.Unless); err != nil {
+			return nil, RuleSyntaxError{Rule: "unless", Err: err}
+		}
+		rules, err := r.compileRuleList(doc.Rules)
+		if err != nil {
+			return nil, err
+		}
+		return Unless(*doc.Unless, rules...), nil
+	case doc.Any != nil:
+		rules, err := r.compileRuleList(doc.Any)
+		if err != nil {
+			return nil, err
+		}
+		return Any(rules...), nil
+	case doc.Not != nil:
+		rules, err := r.compileRuleEntry(doc.Not)
+		if err != nil {
+			return nil, err
+		}
+		if len(rules) != 1 {
+			return nil, RuleSyntaxError{Rule: "not", Err: errors.New("expects exactly one rule")}
+		}
+		return Not(rules[0]), nil
+	case doc.Each != nil:
+		rules, err := r.compileRuleList(doc.Each)
+		if err != nil {
+			return nil, err
+		}
+		return Each(rules...), nil
+	default:
+		sub, err := r.compileDocument(*doc.Schema)
+		if err != nil {
+			return nil, err
+		}
+		return Nested(sub), nil
+	}
+}
+
+// kinds counts the mutually exclusive keys set on a rule entry.
+func (d ruleDocument) kinds() int {
+	n := 0
+	for _, set := range []bool{
+		d.Rule != "", d.When != nil, d.Unless != nil, d.Any != nil, d.Not != nil, d.Each != nil, d.Schema != nil,
+	} {
+		if set {
+			n++
+		}
+	}
+
+	return n
+}
+
+// documentParam converts a JSON rule parameter to the textual form expected by rule factories.
+func documentParam(raw json.RawMessage) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", err
+	}
+
+	switch p := v.(type) {
+	case string:
+		return p, nil
+	case json.Number:
+		return p.String(), nil
+	case bool:
+		return strconv.FormatBool(p), nil
+	default:
+		return "", fmt.Errorf("parameter %s must be a string, number, or boolean", raw)
+	}
+}
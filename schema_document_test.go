@@ -0,0 +1,175 @@
+package validation
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testSchemaDocument = `{
+  "fields": [
+    {"path": "email", "rules": ["required|email", {"rule": "max_length", "params": [20]}]},
+    {"path": "vat", "rules": [{"rule": "required_if", "params": ["country == \"DE\""]}]},
+    {"path": "phone", "rules": [{"when": "contact == \"phone\"", "rules": ["required", "phone_e164"]}]},
+    {"path": "nickname", "rules": [{"unless": "exists(name)", "rules": ["required"]}]},
+    {"path": "contact_value", "rules": [{"any": ["email", "phone_e164"]}]},
+    {"path": "username", "rules": [{"not": "in:admin,root"}]},
+    {"path": "tags", "rules": [{"each": ["slug", "max_length:5"]}]},
+    {"path": "active", "rules": [{"rule": "in", "params": [true]}]},
+    {"path": "address", "rules": [{"schema": {"fields": [{"path": "city", "rules": ["required"]}]}}]}
+  ]
+}`
+
+func TestLoadSchema(t *testing.T) {
+	schema, err := LoadSchema(strings.NewReader(testSchemaDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run(
+		"valid", func(t *testing.T) {
+			res, err := schema.Validate(
+				map[string]any{
+					"email":         "a@example.com",
+					"country":       "FR",
+					"contact":       "email",
+					"name":          "Ann",
+					"contact_value": "+14155552671",
+					"username":      "ann",
+					"tags":          []any{"go", "json"},
+					"active":        true,
+					"address":       map[string]any{"city": "Paris"},
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.HasErrors() {
+				t.Errorf("unexpected errors: %v", res.Errors())
+			}
+		},
+	)
+
+	t.Run(
+		"invalid", func(t *testing.T) {
+			res, err := schema.Validate(
+				map[string]any{
+					"email":         "a-very-long-address@example.com",
+					"country":       "DE",
+					"contact":       "phone",
+					"nickname":      "",
+					"contact_value": "nope",
+					"username":      "root",
+					"tags":          []any{"go", "Not A Slug"},
+					"active":        false,
+					"address":       map[string]any{},
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := pathCodes(res)
+			want := []string{
+				"email:max_length",
+				"vat:required_if",
+				"phone:required",
+				"nickname:required",
+				"contact_value:any",
+				"username:not",
+				"tags.1:slug",
+				"tags.1:max_length",
+				"active:in",
+				"address.city:required",
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("errors = %v\nwant %v", got, want)
+			}
+		},
+	)
+}
+
+func TestLoadSchema_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		doc        string
+		wantSyntax bool
+	}{
+		{"malformed json", `{"fields": [`, false},
+		{"unknown top-level key", `{"feilds": []}`, false},
+		{"missing path", `{"fields": [{"rules": ["required"]}]}`, false},
+		{"unknown rule", `{"fields": [{"path": "a", "rules": ["shiny"]}]}`, true},
+		{"bad params", `{"fields": [{"path": "a", "rules": [{"rule": "max_length", "params": ["x"]}]}]}`, true},
+		{"object param", `{"fields": [{"path": "a", "rules": [{"rule": "in", "params": [{}]}]}]}`, true},
+		{"two kinds", `{"fields": [{"path": "a", "rules": [{"rule": "required", "each": []}]}]}`, true},
+		{"no kind", `{"fields": [{"path": "a", "rules": [{}]}]}`, true},
+		{"unknown key", `{"fields": [{"path": "a", "rules": [{"rule": "required", "x": 1}]}]}`, true},
+		{"rules without when", `{"fields": [{"path": "a", "rules": [{"any": [], "rules": []}]}]}`, true},
+		{"params without rule", `{"fields": [{"path": "a", "rules": [{"each": [], "params": [1]}]}]}`, true},
+		{"not with two rules", `{"fields": [{"path": "a", "rules": [{"not": "email|uuid"}]}]}`, true},
+		{"nested error", `{"fields": [{"path": "a", "rules": [{"schema": {"fields": [{"path": "b", "rules": ["?"]}]}}]}]}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, err := LoadSchema(strings.NewReader(tt.doc))
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				var rse RuleSyntaxError
+				if errors.As(err, &rse) != tt.wantSyntax {
+					t.Errorf("errors.As(RuleSyntaxError) = %v, want %v (err: %v)", !tt.wantSyntax, tt.wantSyntax, err)
+				}
+			},
+		)
+	}
+}
+
+func TestRegistry_LoadSchema(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.Register("even", NoParams(MultipleOf[int](2))); err != nil {
+		t.Fatal(err)
+	}
+
+	schema, err := reg.LoadSchema(strings.NewReader(`{"fields": [{"path": "n", "rules": ["even"]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := schema.Validate(map[string]any{"n": 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.For("n")) != 1 {
+		t.Errorf("expected error for n, got %v", res.Errors())
+	}
+}
+
+func TestLoadSchema_Conditions(t *testing.T) {
+	bad := []string{
+		`{"when": "a == ", "rules": ["required"]}`,
+		`{"unless": "(a", "rules": ["required"]}`,
+		`{"rule": "required_if", "params": ["b == 1 &&"]}`,
+		`{"rule": "required_unless", "params": ["size(b)"]}`,
+		`"required_if:'len(b)'"`,
+	}
+	for _, entry := range bad {
+		doc := `{"fields": [{"path": "phone", "rules": [` + entry + `]}]}`
+		_, err := LoadSchema(strings.NewReader(doc))
+
+		var rse RuleSyntaxError
+		if !errors.As(err, &rse) || !strings.Contains(err.Error(), `field "phone"`) {
+			t.Errorf("%s: err = %v, want a RuleSyntaxError naming field phone", entry, err)
+		}
+	}
+
+	good := `{"fields": [{"path": "phone", "rules": [
+		{"when": "active && !blocked && len(tags) > 0", "rules": ["required"]},
+		{"rule": "required_unless", "params": ["exists(email) || kind == \"guest\""]}
+	]}]}`
+	if _, err := LoadSchema(strings.NewReader(good)); err != nil {
+		t.Errorf("valid conditions: unexpected error %v", err)
+	}
+}
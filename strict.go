@@ -0,0 +1,159 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Strict makes validation report an unknown_field error for every key of a map in the input that no Field path
+// covers, so a misspelled key such as "emial" is rejected instead of silently ignored.
+//
+// A key is known when a field path runs through it: "address.city" makes "address" and "address.city" known, and "*"
+// matches any key or index, so "items.*.sku" covers "sku" in every element of items. The fields of a Nested
+// sub-schema count below the field that holds it, also through Each and the other combinators: with
+// Field("address", Nested(address)), a key under "address" that address does not declare is unknown. A sub-schema
+// that is strict itself checks its own keys instead. Below a field path that no other path extends, the value is not
+// inspected, which leaves it to the field's rules. Struct input has no undeclared keys and is not inspected either.
+//
+// The allow paths name subtrees that are passed through as they are, such as free-form metadata; they may use "*"
+// like field paths, and make their ancestors known as field paths do. Indexes of slices and arrays are never
+// reported, only map keys: Field("items.0.sku") leaves the other elements of items unchecked.
+//
+// Unknown keys are reported after the errors of the declared fields, in sorted order. Strict returns the receiver to
+// support chaining.
+//
+// Example:
+//
+//	schema := validation.New().
+//		Field("email", validation.Required, validation.Email).
+//		Field("items.*.sku", validation.Required).
+//		Strict("metadata")
+//
+//	// {"emial": "a@example.com", "items": [{"sku": "A", "qty": 1}], "metadata": {"any": "thing"}}
+//	// → email: required, emial: unknown_field, items.0.qty: unknown_field
+func (s *Schema) Strict(allow ...string) *Schema {
+	s.strict = true
+	for _, path := range allow {
+		s.allow = append(s.allow, strings.Split(path, "."))
+	}
+
+	return s
+}
+
+// unknownFields returns an unknown_field error for every map key of input that the schema does not cover.
+func (s *Schema) unknownFields(input any) []FieldError {
+	declared := s.declaredPaths(nil, make(map[*Schema]bool))
+
+	var errs []FieldError
+	s.walkUnknown(input, nil, declared, &errs)
+
+	return errs
+}
+
+// declaredPaths returns the field paths of s below prefix, split into segments, followed by those of the sub-schemas
+// that are not strict themselves. seen holds the schemas being expanded, so a recursive schema is expanded once.
+func (s *Schema) declaredPaths(prefix []string, seen map[*Schema]bool) [][]string {
+	seen[s] = true
+	defer delete(seen, s)
+
+	var declared [][]string
+	for _, f := range s.fields {
+		path := joinSegments(prefix, strings.Split(f.path, "."))
+		declared = append(declared, path)
+		declared = append(declared, nestedPaths(f.rules, path, seen)...)
+	}
+
+	return declared
+}
+
+// nestedPaths returns the declared paths of the Nested sub-schemas that rules validate the value at path with. The
+// items of Each are at path.*.
+func nestedPaths(rules []Rule, path []string, seen map[*Schema]bool) [][]string {
+	var declared [][]string
+	for _, r := range rules {
+		d, ok := asDescribed(r)
+		if !ok {
+			continue
+		}
+
+		if d.schema != nil && !d.schema.strict && !seen[d.schema] {
+			declared = append(declared, d.schema.declaredPaths(path, seen)...)
+		}
+		declared = append(declared, nestedPaths(d.inner, path, seen)...)
+		declared = append(declared, nestedPaths(d.items, joinSegments(path, []string{wildcard}), seen)...)
+	}
+
+	return declared
+}
+
+// walkUnknown reports the unknown keys below value, which is at prefix. Indexes of slices and arrays are never unknown;
+// they are walked into like keys that a path goes deeper than.
+func (s *Schema) walkUnknown(value any, prefix []string, declared [][]string, errs *[]FieldError) {
+	list := isList(value)
+	for _, key := range childKeys(value) {
+		path := joinSegments(prefix, []string{key})
+		allowDeeper, allowed := coverage(s.allow, path)
+		if allowed {
+			continue
+		}
+
+		deeper, exact := coverage(declared, path)
+		switch {
+		case deeper || allowDeeper:
+			child, _ := step(value, key)
+			s.walkUnknown(child, path, declared, errs)
+		case !exact && !list:
+			*errs = append(
+				*errs, FieldError{
+					Path:    strings.Join(path, "."),
+					Err:     errUnknownField,
+					Message: errUnknownField.Error(),
+					Code:    errUnknownField.Code(),
+				},
+			)
+		}
+	}
+}
+
+// isList reports whether value, once dereferenced, is a slice or an array.
+func isList(value any) bool {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return false
+		}
+		rv = rv.Elem()
+	}
+
+	return rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array
+}
+
+// errUnknownField is the error Strict reports for an undeclared key.
+var errUnknownField = basicError{"unknown_field", "unknown field validation failed"}
+
+// coverage reports whether any of the patterns goes deeper than path, and whether any matches it exactly. Pattern
+// segments equal to "*" match any segment.
+func coverage(patterns [][]string, path []string) (deeper, exact bool) {
+	for _, pattern := range patterns {
+		if len(pattern) < len(path) || !matchSegments(pattern[:len(path)], path) {
+			continue
+		}
+		if len(pattern) == len(path) {
+			exact = true
+		} else {
+			deeper = true
+		}
+	}
+
+	return deeper, exact
+}
+
+func matchSegments(pattern, path []string) bool {
+	for i, segment := range pattern {
+		if segment != wildcard && segment != path[i] {
+			return false
+		}
+	}
+
+	return true
+}
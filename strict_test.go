@@ -0,0 +1,177 @@
+package validation
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSchemaValidate_Strict(t *testing.T) {
+	address := New().Field("city", Required).Strict()
+
+	schema := New().
+		Field("email", Required, Email).
+		Field("profile", Required).
+		Field("profile.name", MinLength(2)).
+		Field("items.*.sku", Required).
+		Field("address", Nested(address)).
+		Field("settings", Required).
+		Strict("metadata", "items.*.extra")
+
+	tests := []struct {
+		name  string
+		input any
+		want  []string
+	}{
+		{
+			"known keys",
+			map[string]any{
+				"email":    "a@example.com",
+				"profile":  map[string]any{"name": "Ann"},
+				"items":    []any{map[string]any{"sku": "A", "extra": map[string]any{"x": 1}}},
+				"settings": map[string]any{"anything": true},
+				"metadata": map[string]any{"free": "form"},
+			},
+			nil,
+		},
+		{
+			"unknown keys",
+			map[string]any{
+				"emial":    "a@example.com",
+				"profile":  map[string]any{"nmae": "Ann"},
+				"items":    []any{map[string]any{"sku": "A"}, map[string]any{"sku": "B", "qty": 2}},
+				"settings": map[string]any{},
+				"address":  map[string]any{"city": "Paris", "zip": "75001"},
+			},
+			[]string{
+				"email:required",
+				"address.zip:unknown_field",
+				"emial:unknown_field",
+				"items.1.qty:unknown_field",
+				"profile.nmae:unknown_field",
+			},
+		},
+		{
+			"struct input",
+			struct {
+				Email string `json:"email"`
+				Other string `json:"other"`
+			}{Email: "a@example.com"},
+			[]string{"profile:required", "settings:required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				res, err := schema.Validate(tt.input)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got := pathCodes(res)
+				if !slices.Equal(got, tt.want) {
+					t.Errorf("errors = %v\nwant %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestSchemaValidate_StrictFailFast(t *testing.T) {
+	schema := New().Field("name", Required).Strict().FailFast()
+
+	res, err := schema.Validate(map[string]any{"name": "Ann", "b": 1, "a": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.Errors(); len(got) != 1 || got[0].Path != "a" || got[0].Code != "unknown_field" {
+		t.Errorf("errors = %v, want only a:unknown_field", got)
+	}
+
+	res, err = schema.Validate(map[string]any{"b": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.Errors(); len(got) != 1 || got[0].Code != "required" {
+		t.Errorf("errors = %v, want only name:required", got)
+	}
+}
+
+func TestSchemaValidate_StrictNested(t *testing.T) {
+	address := New().Field("city", Required)
+	item := New().Field("sku", Required).Field("meta", Nested(New().Field("color", Required)))
+	checked := New().Field("zip", Required).Strict()
+
+	tree := New().Field("name", Required)
+	tree.Field("children", Each(Nested(tree)))
+
+	schema := New().
+		Field("address", Nested(address)).
+		Field("items", Each(Nested(item))).
+		Field("billing", When("exists(billing)", Nested(address))).
+		Field("checked", Nested(checked)).
+		Field("tree", Nested(tree)).
+		Strict()
+
+	input := map[string]any{
+		"address": map[string]any{"city": "Paris", "zip": "75001"},
+		"items": []any{
+			map[string]any{"sku": "A", "meta": map[string]any{"color": "red", "size": "L"}},
+			map[string]any{"sku": "B", "qty": 2},
+		},
+		"billing": map[string]any{"city": "Lyon", "street": "Main"},
+		"checked": map[string]any{"zip": "1", "extra": true},
+		"tree": map[string]any{
+			"name":     "root",
+			"children": []any{map[string]any{"name": "leaf", "age": 1}},
+			"depth":    0,
+		},
+	}
+
+	res, err := schema.Validate(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"checked.extra:unknown_field",
+		"address.zip:unknown_field",
+		"billing.street:unknown_field",
+		"items.0.meta.size:unknown_field",
+		"items.1.qty:unknown_field",
+		"tree.depth:unknown_field",
+	}
+	if got := pathCodes(res); !slices.Equal(got, want) {
+		t.Errorf("errors = %v\nwant %v", got, want)
+	}
+}
+
+func TestSchemaValidate_StrictAllowAndIndexes(t *testing.T) {
+	schema := New().
+		Field("name", Required).
+		Field("items.0.sku", Required).
+		Strict("metadata.extra", "tags.*.free")
+
+	input := map[string]any{
+		"name":     "Ann",
+		"metadata": map[string]any{"extra": map[string]any{"any": 1}, "other": 2},
+		"items":    []any{map[string]any{"sku": "A", "qty": 1}, map[string]any{"sku": "B"}},
+		"tags":     []any{map[string]any{"free": true, "x": 1}},
+	}
+
+	res, err := schema.Validate(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"items.0.qty:unknown_field", "metadata.other:unknown_field", "tags.0.x:unknown_field"}
+	if got := pathCodes(res); !slices.Equal(got, want) {
+		t.Errorf("errors = %v\nwant %v", got, want)
+	}
+
+	res, err = New().Field("items.0", Required).Strict().Validate(map[string]any{"items": []any{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("indexes reported as unknown: %v", pathCodes(res))
+	}
+}
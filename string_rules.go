@@ -3,7 +3,7 @@ package validation
 import (
 	"encoding/base64"
 	"encoding/json"
-	"net"
+	"reflect"
 	"regexp"
 	"strings"
 	"unicode/utf8"
@@ -45,7 +45,7 @@ var (
 //	validation.Alpha.Validate("hi there")   // fail — contains space
 var Alpha Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !regexAlpha.MatchString(str) {
 			return basicError{"alpha", "alpha validation failed"}
 		}
@@ -71,7 +71,7 @@ var Alpha Rule = RuleFunc(
 //	validation.AlphaDash.Validate("hello@world") // fail — @ not allowed
 var AlphaDash Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !regexAlphaDash.MatchString(str) {
 			return basicError{"alpha_dash", "alpha dash validation failed"}
 		}
@@ -95,7 +95,7 @@ var AlphaDash Rule = RuleFunc(
 //	validation.AlphaNum.Validate("hello 1")  // fail — space not allowed
 var AlphaNum Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !regexAlphaNum.MatchString(str) {
 			return basicError{"alpha_num", "alpha num validation failed"}
 		}
@@ -119,7 +119,7 @@ var AlphaNum Rule = RuleFunc(
 //	validation.AlphaSpace.Validate("hello-world") // fail — dash not allowed
 var AlphaSpace Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !regexAlphaSpace.MatchString(str) {
 			return basicError{"alpha_space", "alpha space validation failed"}
 		}
@@ -141,7 +141,7 @@ var AlphaSpace Rule = RuleFunc(
 //	validation.ASCII.Validate("hello\t") // pass — tab is ASCII
 var ASCII Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return basicError{"ascii", "ascii validation failed"}
 		}
@@ -172,7 +172,7 @@ var ASCII Rule = RuleFunc(
 //	validation.Base64.Validate("not-base64!")  // fail
 var Base64 Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return basicError{"base64", "base64 validation failed"}
 		}
@@ -198,7 +198,7 @@ var Base64 Rule = RuleFunc(
 func Contains(sub string) Rule {
 	return RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || !strings.Contains(str, sub) {
 				return containsError{Substring: sub}
 			}
@@ -224,7 +224,7 @@ func Contains(sub string) Rule {
 //	validation.CreditCard.Validate("1234567890123456")    // fail — invalid Luhn
 var CreditCard Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok {
 			return basicError{"credit_card", "credit card validation failed"}
 		}
@@ -263,45 +263,37 @@ var CreditCard Rule = RuleFunc(
 //	validation.Email.Validate("notanemail")              // fail — no @
 //	validation.Email.Validate("user@")                   // fail — empty domain
 //	validation.Email.Validate("@example.com")            // fail — empty username
-var Email Rule = RuleFunc(
+var Email Rule = withFormat("email", RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !isEmail(str) {
 			return basicError{"email", "email validation failed"}
 		}
 
 		return nil
 	},
-)
+))
 
 // EmailMX is a Rule that validates the value is a well-formed email address whose domain has at least one MX record.
 //
 // Fails if:
 //   - value is not a string or is not a valid email format (returns basicError{"email", "email validation failed"})
 //   - the domain has no MX records (returns basicError{"email_mx", "email mx validation failed"})
+//   - the lookup times out (returns basicError{"email_mx_timeout", "email mx lookup timed out"})
+//   - the lookup fails for another reason (returns basicError{"email_mx_unavailable", "email mx lookup failed"})
+//
+// EmailMX is a ContextRule: the lookup uses the context passed to Schema.ValidateContext, so a deadline bounds it and
+// cancellation stops it, in which case the context's error is returned. Plain Validate calls have no deadline.
 //
-// Note: this rule performs a network call on every invocation. Avoid in hot paths; cache results externally if needed.
+// Note: this rule performs a network call on every invocation. Use EmailMXWith for a per-lookup timeout, a result
+// cache, or a custom resolver.
 //
 // Examples:
 //
 //	validation.EmailMX.Validate("user@gmail.com")   // pass — gmail.com has MX records
 //	validation.EmailMX.Validate("user@invalid.com") // fail — example.com has no MX records
 //	validation.EmailMX.Validate("notanemail")       // fail — format invalid
-var EmailMX Rule = RuleFunc(
-	func(value any) error {
-		str, ok := value.(string)
-		if !ok || !isEmail(str) {
-			return basicError{"email", "email validation failed"}
-		}
-
-		domain := strings.SplitN(str, "@", 2)[1]
-		if _, err := net.LookupMX(domain); err != nil {
-			return basicError{"email_mx", "email mx validation failed"}
-		}
-
-		return nil
-	},
-)
+var EmailMX = EmailMXWith(nil, EmailMXOptions{})
 
 // EndsWith returns a Rule that validates the value is a string ending with the given suffix.
 //
@@ -316,7 +308,7 @@ var EmailMX Rule = RuleFunc(
 func EndsWith(suffix string) Rule {
 	return RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || !strings.HasSuffix(str, suffix) {
 				return endsWithError{Suffix: suffix}
 			}
@@ -342,7 +334,7 @@ func EndsWith(suffix string) Rule {
 //	validation.HexColor.Validate("#GGHHII")  // fail — not hex digits
 var HexColor Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !regexHexColor.MatchString(str) {
 			return basicError{"hex_color", "hex color validation failed"}
 		}
@@ -370,7 +362,7 @@ var HexColor Rule = RuleFunc(
 //	validation.JSON.Validate(``)                // fail
 var JSON Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !json.Valid([]byte(str)) {
 			return basicError{"json", "json validation failed"}
 		}
@@ -396,7 +388,7 @@ var JSON Rule = RuleFunc(
 //	validation.JWT.Validate("a.b")         // fail — only two segments
 var JWT Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !regexJWT.MatchString(str) {
 			return basicError{"jwt", "jwt validation failed"}
 		}
@@ -420,16 +412,16 @@ var JWT Rule = RuleFunc(
 //	validation.Length(5).Validate("hi")       // fail — 2 runes
 //	validation.Length(5).Validate("too long") // fail — 8 runes
 func Length(l int) Rule {
-	return RuleFunc(
+	return withKeywords("string", map[string]any{"minLength": l, "maxLength": l}, RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || utf8.RuneCountInString(str) != l {
 				return lengthError{Length: l}
 			}
 
 			return nil
 		},
-	)
+	))
 }
 
 // Lowercase is a Rule that validates the value is a string containing only lowercase characters.
@@ -446,7 +438,7 @@ func Length(l int) Rule {
 //	validation.Lowercase.Validate("HELLO")       // fail
 var Lowercase Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || str != strings.ToLower(str) {
 			return basicError{"lowercase", "lowercase validation failed"}
 		}
@@ -469,16 +461,16 @@ var Lowercase Rule = RuleFunc(
 //	validation.MaxLength(3).Validate("too long")      // fail — 8 runes > 3
 //	validation.MaxLength(3).Validate("héé")           // pass — 3 runes
 func MaxLength(l int) Rule {
-	return RuleFunc(
+	return withKeywords("string", map[string]any{"maxLength": l}, RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || utf8.RuneCountInString(str) > l {
 				return maxLengthError{Length: l}
 			}
 
 			return nil
 		},
-	)
+	))
 }
 
 // MinLength returns a Rule that validates the string's rune count is at least l.
@@ -495,16 +487,16 @@ func MaxLength(l int) Rule {
 //	validation.MinLength(3).Validate("hi")    // fail — 2 runes < 3
 //	validation.MinLength(3).Validate("héé")   // pass — 3 runes
 func MinLength(l int) Rule {
-	return RuleFunc(
+	return withKeywords("string", map[string]any{"minLength": l}, RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || utf8.RuneCountInString(str) < l {
 				return minLengthError{Length: l}
 			}
 
 			return nil
 		},
-	)
+	))
 }
 
 // NotRegex returns a Rule that validates the value is a string that does NOT match the given regular expression.
@@ -529,7 +521,7 @@ func NotRegex(pattern string) Rule {
 				return RuleSyntaxError{Rule: "NotRegex", Err: err}
 			}
 
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || re.MatchString(str) {
 				return notRegexError{Pattern: pattern}
 			}
@@ -556,7 +548,7 @@ func NotRegex(pattern string) Rule {
 //	validation.PhoneE164.Validate("+0123456789")   // fail — country code starts with 0
 var PhoneE164 Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !regexPhoneE164.MatchString(str) {
 			return basicError{"phone_e164", "phone e164 validation failed"}
 		}
@@ -582,20 +574,20 @@ var PhoneE164 Rule = RuleFunc(
 func Regex(pattern string) Rule {
 	re, err := regexp.Compile(pattern)
 
-	return RuleFunc(
+	return withKeywords("string", map[string]any{"pattern": pattern}, RuleFunc(
 		func(value any) error {
 			if err != nil {
 				return RuleSyntaxError{Rule: "Regex", Err: err}
 			}
 
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || !re.MatchString(str) {
 				return regexError{Pattern: pattern}
 			}
 
 			return nil
 		},
-	)
+	))
 }
 
 // Semver is a Rule that validates the value is a valid semantic version string (semver.org).
@@ -618,7 +610,7 @@ func Regex(pattern string) Rule {
 //	validation.Semver.Validate("01.0.0")              // fail — leading zero
 var Semver Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !regexSemver.MatchString(str) {
 			return basicError{"semver", "semver validation failed"}
 		}
@@ -646,7 +638,7 @@ var Semver Rule = RuleFunc(
 //	validation.Slug.Validate("double--dash")  // fail — consecutive hyphens
 var Slug Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !regexSlug.MatchString(str) {
 			return basicError{"slug", "slug validation failed"}
 		}
@@ -668,7 +660,7 @@ var Slug Rule = RuleFunc(
 func StartsWith(prefix string) Rule {
 	return RuleFunc(
 		func(value any) error {
-			str, ok := value.(string)
+			str, ok := asString(value)
 			if !ok || !strings.HasPrefix(str, prefix) {
 				return startsWithError{Prefix: prefix}
 			}
@@ -692,7 +684,7 @@ func StartsWith(prefix string) Rule {
 //	validation.Uppercase.Validate("hello")       // fail
 var Uppercase Rule = RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || str != strings.ToUpper(str) {
 			return basicError{"uppercase", "uppercase validation failed"}
 		}
@@ -711,16 +703,36 @@ var Uppercase Rule = RuleFunc(
 //
 //	validation.UUID.Validate("550e8400-e29b-41d4-a716-446655440000") // pass
 //	validation.UUID.Validate("not-a-uuid")                           // fail
-var UUID Rule = RuleFunc(
+var UUID Rule = withFormat("uuid", RuleFunc(
 	func(value any) error {
-		str, ok := value.(string)
+		str, ok := asString(value)
 		if !ok || !regexUUID.MatchString(str) {
 			return basicError{"uuid", "uuid validation failed"}
 		}
 
 		return nil
 	},
-)
+))
+
+// asString returns the text of a string rule's value. Besides string it accepts named string types, such as
+// `type Email string`, and byte slices, such as []byte and json.RawMessage. Other types, including fmt.Stringer
+// implementations, are not text to the string rules: time.Time and similar types would be validated by their display
+// form.
+func asString(value any) (string, bool) {
+	if s, ok := value.(string); ok {
+		return s, true
+	}
+
+	rv := reflect.ValueOf(value)
+	switch {
+	case rv.Kind() == reflect.String:
+		return rv.String(), true
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		return string(rv.Bytes()), true
+	default:
+		return "", false
+	}
+}
 
 func luhn(number string) bool {
 	sum := 0
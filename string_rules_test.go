@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 )
@@ -666,3 +667,41 @@ func TestSlug(t *testing.T) {
 		}
 	}
 }
+
+func TestStringRules_NamedTypesAndBytes(t *testing.T) {
+	type email string
+	type status string
+	type stamp struct{}
+
+	tests := []struct {
+		name    string
+		rule    Rule
+		value   any
+		wantErr bool
+	}{
+		{"alpha named", Alpha, status("active"), false},
+		{"alpha named invalid", Alpha, status("active1"), true},
+		{"min length named", MinLength(3), status("ok"), true},
+		{"email named", Email, email("user@example.com"), false},
+		{"email named invalid", Email, email("user@"), true},
+		{"uuid bytes", UUID, []byte("550e8400-e29b-41d4-a716-446655440000"), false},
+		{"json raw message", JSON, json.RawMessage(`{"a":1}`), false},
+		{"regex bytes", Regex(`^\d+$`), []byte("123"), false},
+		{"digits named", Digits(4), status("1234"), false},
+		{"ip named", IP, status("10.0.0.1"), false},
+		{"date time named", DateTime, status("2024-01-01"), false},
+		{"stringer", Alpha, stringerValue{}, true},
+		{"struct", Alpha, stamp{}, true},
+		{"int slice", Alpha, []int{1}, true},
+	}
+	for _, tt := range tests {
+		err := tt.rule.Validate(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate(%v) error = %v, wantErr %v", tt.name, tt.value, err, tt.wantErr)
+		}
+	}
+}
+
+type stringerValue struct{}
+
+func (stringerValue) String() string { return "abc" }
@@ -0,0 +1,176 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FromStruct builds a Schema from the `validate` struct tags of v's type, so a request DTO can carry its own rules.
+//
+// v may be a struct, a pointer to a struct, or a typed nil pointer such as (*T)(nil); only its type is inspected.
+// Field paths follow the same resolution as InputBag: the first comma-segment of the `json` tag (when present and not
+// "-"), then the exported Go field name. Embedded structs contribute their fields at the parent level.
+//
+// A tag is a comma-separated list of rule names, each optionally followed by "=" and space-separated parameters. Rule
+// names are the error codes the rules report ("required", "max_length", "date_time_format", ...). Wrap a parameter in
+// single quotes when it contains a comma or a space; double a quote to escape it.
+//
+//	type SignUp struct {
+//		Email    string   `json:"email" validate:"required,email,max_length=255"`
+//		Age      int      `json:"age" validate:"between=18 130"`
+//		Role     string   `json:"role" validate:"in=admin editor viewer"`
+//		Phone    string   `json:"phone" validate:"required_if='contact == \"phone\"'"`
+//		Address  *Address `json:"address" validate:"required"`
+//		Contacts []Person `json:"contacts"`
+//	}
+//
+// Fields of struct type (or pointer to struct) are validated with Nested against a schema built from their own tags,
+// and slices or arrays of structs with Each(Nested(...)), so nested rules only run when the nested value is present.
+// A `validate:"-"` tag skips the field entirely.
+//
+// Numeric rules built from tags (min, max, between, gt, ...) accept any numeric kind, including named types.
+//
+// An unknown rule name or malformed parameter is reported as a RuleSyntaxError; build schemas at startup.
+func FromStruct(v any) (*Schema, error) {
+	return defaultRegistry.FromStruct(v)
+}
+
+// FromStruct builds a Schema from the `validate` struct tags of v's type, resolving rule names through the registry.
+// See the package-level FromStruct for the tag syntax.
+func (r *Registry) FromStruct(v any) (*Schema, error) {
+	rt := reflect.TypeOf(v)
+	for rt != nil && rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt == nil || rt.Kind() != reflect.Struct {
+		return nil, RuleSyntaxError{Rule: "FromStruct", Err: fmt.Errorf("expected a struct, got %v", rt)}
+	}
+
+	b := structSchemaBuilder{registry: r, schemas: make(map[reflect.Type]*Schema)}
+
+	return b.build(rt)
+}
+
+type structSchemaBuilder struct {
+	registry *Registry
+	schemas  map[reflect.Type]*Schema
+}
+
+// build returns the schema for a struct type. Schemas are cached per type before their fields are added, which keeps
+// self-referencing types from recursing forever.
+func (b structSchemaBuilder) build(rt reflect.Type) (*Schema, error) {
+	if s, ok := b.schemas[rt]; ok {
+		return s, nil
+	}
+
+	s := New()
+	b.schemas[rt] = s
+
+	if err := b.addFields(s, rt); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (b structSchemaBuilder) addFields(s *Schema, rt reflect.Type) error {
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.Anonymous {
+			ft := derefType(field.Type)
+			if ft.Kind() == reflect.Struct {
+				if err := b.addFields(s, ft); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+
+		tag := field.Tag.Get("validate")
+		if tag == "-" {
+			continue
+		}
+
+		rules, err := b.registry.parseRules(tag, tagSyntax)
+		if err != nil {
+			return withField(err, name)
+		}
+
+		nested, err := b.nestedRule(field.Type)
+		if err != nil {
+			return err
+		}
+		if nested != nil {
+			rules = append(rules, nested)
+		}
+
+		if len(rules) > 0 {
+			s.Field(name, rules...)
+		}
+	}
+
+	return nil
+}
+
+// nestedRule returns the rule validating the inside of a struct, or slice/array of structs, field type. It returns nil
+// for other types and for structs that declare no rules.
+func (b structSchemaBuilder) nestedRule(ft reflect.Type) (Rule, error) {
+	ft = derefType(ft)
+
+	each := false
+	if ft.Kind() == reflect.Slice || ft.Kind() == reflect.Array {
+		ft = derefType(ft.Elem())
+		each = true
+	}
+	if ft.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	_, inProgress := b.schemas[ft]
+	sub, err := b.build(ft)
+	if err != nil {
+		return nil, err
+	}
+	if !inProgress && len(sub.fields) == 0 {
+		return nil, nil
+	}
+
+	if each {
+		return Each(Nested(sub)), nil
+	}
+
+	return Nested(sub), nil
+}
+
+// jsonFieldName returns the path segment under which InputBag resolves a struct field, and false when the field is
+// hidden by a `json:"-"` tag.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	if tag, ok := field.Tag.Lookup("json"); ok {
+		tagName := strings.Split(tag, ",")[0]
+		if tagName == "-" {
+			return "", false
+		}
+		if tagName != "" {
+			return tagName, true
+		}
+	}
+
+	return field.Name, true
+}
+
+func derefType(rt reflect.Type) reflect.Type {
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	return rt
+}
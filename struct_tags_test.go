@@ -0,0 +1,160 @@
+package validation
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+type tagAddress struct {
+	Street string `json:"street" validate:"required"`
+	City   string `json:"city" validate:"required,min_length=2"`
+}
+
+type tagBase struct {
+	ID int `json:"id" validate:"gt=0"`
+}
+
+type tagPerson struct {
+	Name string `validate:"required"`
+}
+
+type tagNode struct {
+	Name     string    `json:"name" validate:"required"`
+	Children []tagNode `json:"children"`
+}
+
+type tagSignUp struct {
+	tagBase
+	Email    string      `json:"email,omitempty" validate:"required,email,max_length=255"`
+	Age      int         `json:"age" validate:"between=18 130"`
+	Role     string      `json:"role" validate:"in=admin editor"`
+	Contact  string      `json:"contact"`
+	Phone    string      `json:"phone" validate:"required_if='contact == \"phone\"'"`
+	Address  *tagAddress `json:"address" validate:"required"`
+	Billing  *tagAddress `json:"billing"`
+	Contacts []tagPerson `json:"contacts" validate:"max_size=2"`
+	Secret   string      `json:"-" validate:"required"`
+	Skipped  string      `json:"skipped" validate:"-"`
+	private  string      `validate:"required"`
+}
+
+func TestFromStruct(t *testing.T) {
+	schema, err := FromStruct(tagSignUp{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run(
+		"valid", func(t *testing.T) {
+			res, err := schema.Validate(
+				map[string]any{
+					"id":      1,
+					"email":   "a@example.com",
+					"age":     float64(30),
+					"role":    "admin",
+					"address": map[string]any{"street": "Main", "city": "Oslo"},
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.HasErrors() {
+				t.Errorf("unexpected errors: %v", res.Errors())
+			}
+		},
+	)
+
+	t.Run(
+		"invalid", func(t *testing.T) {
+			res, err := schema.Validate(
+				tagSignUp{
+					Email:    "nope",
+					Age:      12,
+					Role:     "root",
+					Contact:  "phone",
+					Address:  &tagAddress{City: "X"},
+					Contacts: []tagPerson{{Name: "a"}, {}, {Name: "c"}},
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := pathCodes(res)
+			want := []string{
+				"id:gt",
+				"email:email",
+				"age:between",
+				"role:in",
+				"phone:required_if",
+				"address.street:required",
+				"address.city:min_length",
+				"contacts:max_size",
+				"contacts.1.Name:required",
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("errors = %v\nwant %v", got, want)
+			}
+		},
+	)
+}
+
+func TestFromStruct_RecursiveType(t *testing.T) {
+	schema, err := FromStruct(&tagNode{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := schema.Validate(tagNode{Name: "root", Children: []tagNode{{Name: "a"}, {Children: []tagNode{{}}}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, fe := range res.Errors() {
+		got = append(got, fe.Path)
+	}
+	want := []string{"children.1.name", "children.1.children.0.name"}
+	if !slices.Equal(got, want) {
+		t.Errorf("errors = %v, want %v", got, want)
+	}
+}
+
+func TestFromStruct_Errors(t *testing.T) {
+	type unknownRule struct {
+		Name string `validate:"required,shiny"`
+	}
+	type badParam struct {
+		Name string `validate:"max_length=abc"`
+	}
+	type badRegex struct {
+		Name string `validate:"regex=["`
+	}
+	type unterminated struct {
+		Name string `validate:"regex='abc"`
+	}
+
+	tests := []struct {
+		name string
+		v    any
+	}{
+		{"unknown rule", unknownRule{}},
+		{"bad parameter", badParam{}},
+		{"bad regex", badRegex{}},
+		{"unterminated quote", unterminated{}},
+		{"not a struct", 42},
+		{"nil", nil},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, err := FromStruct(tt.v)
+				var rse RuleSyntaxError
+				if !errors.As(err, &rse) {
+					t.Errorf("expected RuleSyntaxError, got %v", err)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,178 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Translator renders a human-readable message for a FieldError in a locale such as "en" or "fa-IR". It reports false
+// when it has no message for the error's code in that locale.
+type Translator interface {
+	Translate(locale string, fe FieldError) (string, bool)
+}
+
+// Catalog is a Translator backed by message templates keyed by locale and error code. A template refers to the
+// error's Params by name in braces, e.g. "must be at most {length} characters". Slices in Params render as a
+// comma-separated list and times in RFC 3339; a placeholder without a matching param is left as is.
+//
+// Templates describe the field without naming it. For an error with a Label, the label is put in front ("Email
+// address is required"), unless the template places it itself with {label}.
+//
+// Locales are matched case-insensitively, falling back from a regional locale to its language ("fa-IR" → "fa").
+//
+// A Catalog is safe for concurrent use.
+type Catalog struct {
+	mu        sync.RWMutex
+	templates map[string]map[string]string
+}
+
+// defaultCatalog holds the bundled locales. It is never modified; NewCatalog hands out copies.
+var defaultCatalog = NewCatalog()
+
+var regexTemplateParam = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+// NewCatalog returns a Catalog pre-populated with templates for every built-in error code in English ("en") and
+// Persian ("fa").
+//
+// Example:
+//
+//	catalog := validation.NewCatalog()
+//	catalog.Add("en", map[string]string{"sku": "must be a valid SKU"})
+//	catalog.Add("de", map[string]string{"required": "ist erforderlich"})
+//
+//	localized := res.LocalizeWith(catalog, "de")
+func NewCatalog() *Catalog {
+	c := &Catalog{templates: make(map[string]map[string]string)}
+	c.Add("en", englishMessages)
+	c.Add("fa", persianMessages)
+
+	return c
+}
+
+// Add registers templates for a locale, keyed by error code. Templates for codes that already exist in the locale are
+// replaced, so an application can reword built-in messages or add messages for its own rules.
+func (c *Catalog) Add(locale string, templates map[string]string) {
+	locale = strings.ToLower(locale)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.templates[locale] == nil {
+		c.templates[locale] = make(map[string]string, len(templates))
+	}
+	for code, tmpl := range templates {
+		c.templates[locale][code] = tmpl
+	}
+}
+
+// Translate renders the template for fe.Code in locale, or reports false when there is none.
+func (c *Catalog) Translate(locale string, fe FieldError) (string, bool) {
+	if fe.Code == "" {
+		return "", false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, l := range localeFallbacks(locale) {
+		if tmpl, ok := c.templates[l][fe.Code]; ok {
+			msg := fe.render(tmpl)
+			if fe.Label != "" && !strings.Contains(tmpl, "{label}") {
+				msg = fe.Label + " " + msg
+			}
+			return msg, true
+		}
+	}
+
+	return "", false
+}
+
+// localeFallbacks returns the lookup order for a locale: the locale itself, then its language.
+func localeFallbacks(locale string) []string {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if lang, _, ok := strings.Cut(locale, "-"); ok {
+		return []string{locale, lang}
+	}
+
+	return []string{locale}
+}
+
+// render substitutes the {name} placeholders of a template with the error's formatted params. {label} stands for the
+// field's label, or its path when it has none.
+func (e FieldError) render(tmpl string) string {
+	return regexTemplateParam.ReplaceAllStringFunc(
+		tmpl, func(placeholder string) string {
+			name := placeholder[1 : len(placeholder)-1]
+			if v, ok := e.Params[name]; ok {
+				return formatParam(v)
+			}
+			if name == "label" {
+				if e.Label != "" {
+					return e.Label
+				}
+				return e.Path
+			}
+			return placeholder
+		},
+	)
+}
+
+func formatParam(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		parts := make([]string, rv.Len())
+		for i := range parts {
+			parts[i] = formatParam(rv.Index(i).Interface())
+		}
+		return strings.Join(parts, ", ")
+	}
+
+	return fmt.Sprint(v)
+}
+
+// Localize returns a copy of the result whose messages are rendered in locale from the bundled English and Persian
+// catalogs. Errors whose code has no template in the locale, such as those of custom rules, keep their message, as do
+// messages set with Schema.Messages.
+//
+// Example:
+//
+//	for _, fe := range res.Localize("fa").Errors() {
+//		fmt.Println(fe.Path, fe.Message) // name حداقل باید 3 نویسه باشد
+//	}
+func (r *Result) Localize(locale string) *Result {
+	return r.LocalizeWith(defaultCatalog, locale)
+}
+
+// LocalizeWith is like Localize but renders messages with t, for example a Catalog with application templates.
+func (r *Result) LocalizeWith(t Translator, locale string) *Result {
+	if r.errors == nil {
+		return &Result{}
+	}
+
+	errs := make([]FieldError, len(r.errors))
+	for i, fe := range r.errors {
+		if !fe.custom {
+			if msg, ok := t.Translate(locale, fe); ok {
+				fe.Message = msg
+			}
+		}
+		errs[i] = fe
+	}
+
+	return &Result{errors: errs}
+}
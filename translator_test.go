@@ -0,0 +1,128 @@
+package validation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCatalog_Translate(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name   string
+		locale string
+		code   string
+		params map[string]any
+		want   string
+		wantOK bool
+	}{
+		{"int param", "en", "max_length", map[string]any{"length": 5}, "must be at most 5 characters", true},
+		{"two params", "en", "between", map[string]any{"min": 1, "max": 9.5}, "must be between 1 and 9.5", true},
+		{"slice param", "en", "in", map[string]any{"values": []string{"a", "b"}}, "must be one of a, b", true},
+		{"time param", "en", "after", map[string]any{"time": at}, "must be after 2024-01-02T03:04:05Z", true},
+		{"missing param", "en", "min", nil, "must be at least {value}", true},
+		{"persian", "fa", "min_length", map[string]any{"length": 3}, "حداقل باید 3 نویسه باشد", true},
+		{"regional fallback", "fa-IR", "required", nil, "الزامی است", true},
+		{"underscore and case", "EN_us", "required", nil, "is required", true},
+		{"unknown locale", "de", "required", nil, "", false},
+		{"unknown code", "en", "sku", nil, "", false},
+	}
+	catalog := NewCatalog()
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, ok := catalog.Translate(tt.locale, FieldError{Code: tt.code, Params: tt.params})
+				if got != tt.want || ok != tt.wantOK {
+					t.Errorf("Translate() = (%q, %v), want (%q, %v)", got, ok, tt.want, tt.wantOK)
+				}
+			},
+		)
+	}
+}
+
+func TestCatalog_Add(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.Add("en", map[string]string{"required": "cannot be blank", "sku": "must be a SKU like {example}"})
+	catalog.Add("de", map[string]string{"required": "ist erforderlich"})
+
+	if got, _ := catalog.Translate("en", FieldError{Code: "required"}); got != "cannot be blank" {
+		t.Errorf("overridden template = %q", got)
+	}
+	got, _ := catalog.Translate("en", FieldError{Code: "sku", Params: map[string]any{"example": "AB-12"}})
+	if got != "must be a SKU like AB-12" {
+		t.Errorf("custom template = %q", got)
+	}
+	if got, _ := catalog.Translate("de-AT", FieldError{Code: "required"}); got != "ist erforderlich" {
+		t.Errorf("new locale = %q", got)
+	}
+	if got, _ := NewCatalog().Translate("en", FieldError{Code: "required"}); got != "is required" {
+		t.Errorf("Add leaked into another catalog: %q", got)
+	}
+}
+
+func TestCatalog_Complete(t *testing.T) {
+	for code := range englishMessages {
+		if _, ok := persianMessages[code]; !ok {
+			t.Errorf("fa has no template for %q", code)
+		}
+	}
+	for code := range persianMessages {
+		if _, ok := englishMessages[code]; !ok {
+			t.Errorf("en has no template for %q", code)
+		}
+	}
+}
+
+func TestResult_Localize(t *testing.T) {
+	sku := RuleFunc(
+		func(any) error {
+			return basicError{"sku", "invalid sku"}
+		},
+	)
+	schema := New().
+		Field("name", Required, MinLength(3)).
+		Field("code", sku)
+
+	res, err := schema.Validate(map[string]any{"name": "ab", "code": "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fa := res.Localize("fa")
+	if got := fa.For("name")[0].Message; got != "حداقل باید 3 نویسه باشد" {
+		t.Errorf("name message = %q", got)
+	}
+	if got := fa.For("code")[0].Message; got != "invalid sku" {
+		t.Errorf("untranslated message = %q, want the original", got)
+	}
+	if got := res.For("name")[0].Message; got == fa.For("name")[0].Message {
+		t.Error("Localize modified the original result")
+	}
+	if (&Result{}).Localize("en").HasErrors() {
+		t.Error("empty result gained errors")
+	}
+}
+
+func TestResult_Localize_MessagesAndLabel(t *testing.T) {
+	schema := New().
+		Field("email", Required).Label("ایمیل").
+		Field("name", Required).Messages(map[string]string{"required": "We need your name"})
+
+	res, err := schema.Validate(map[string]any{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fa := res.Localize("fa")
+	if got := fa.For("email")[0].Message; got != "ایمیل الزامی است" {
+		t.Errorf("labeled message = %q", got)
+	}
+	if got := fa.For("name")[0].Message; got != "We need your name" {
+		t.Errorf("overridden message = %q, want it unchanged", got)
+	}
+
+	catalog := NewCatalog()
+	catalog.Add("en", map[string]string{"required": "please fill in {label}"})
+	if got := res.LocalizeWith(catalog, "en").For("email")[0].Message; got != "please fill in ایمیل" {
+		t.Errorf("template with {label} = %q", got)
+	}
+}
@@ -20,7 +20,10 @@
 // not "-"), then the exported field name.
 package validation
 
-import "errors"
+import (
+	"context"
+	"errors"
+)
 
 // Rule validates a single value and returns nil on success or an error describing the failure.
 type Rule interface {
@@ -50,6 +53,26 @@ func (f InputRuleFunc) Validate(value any) error { return f(value, nil) }
 // ValidateWithInput satisfies the InputRule interface.
 func (f InputRuleFunc) ValidateWithInput(value any, input *InputBag) error { return f(value, input) }
 
+// ContextRule is a Rule that performs I/O, such as a DNS lookup or a uniqueness query, and therefore receives the
+// context.Context passed to Schema.ValidateContext along with the full validated input. Rules should honour the
+// context's deadline and cancellation, and return ctx.Err() when the context ends before they can decide.
+type ContextRule interface {
+	Rule
+	ValidateContext(ctx context.Context, value any, input *InputBag) error
+}
+
+// ContextRuleFunc adapts a plain function to the ContextRule interface.
+type ContextRuleFunc func(ctx context.Context, value any, input *InputBag) error
+
+// Validate satisfies the Rule interface by calling the function with context.Background and a nil input.
+// Schema.Validate and Schema.ValidateContext always call ValidateContext instead.
+func (f ContextRuleFunc) Validate(value any) error { return f(context.Background(), value, nil) }
+
+// ValidateContext satisfies the ContextRule interface.
+func (f ContextRuleFunc) ValidateContext(ctx context.Context, value any, input *InputBag) error {
+	return f(ctx, value, input)
+}
+
 // presenceRule marks a rule that must run even when the field value is absent (nil).
 // Schema.Validate skips all other rules when value is nil.
 type presenceRule interface {
@@ -71,17 +94,58 @@ func (f presenceInputRuleFunc) ValidateWithInput(value any, input *InputBag) err
 }
 func (presenceInputRuleFunc) isPresenceCheck() {}
 
+// nullCheck marks a rule that also runs for a field that is present with a nil value, such as the JSON Schema type
+// keyword, for which null is a value like any other. Unlike presence rules, it is skipped for absent fields.
+type nullCheck interface {
+	isNullCheck()
+}
+
+// nullInputRuleFunc is an InputRuleFunc that also satisfies nullCheck.
+type nullInputRuleFunc func(value any, input *InputBag) error
+
+func (f nullInputRuleFunc) Validate(value any) error { return f(value, nil) }
+func (f nullInputRuleFunc) ValidateWithInput(value any, input *InputBag) error {
+	return f(value, input)
+}
+func (nullInputRuleFunc) isNullCheck() {}
+
+// runsForNil reports whether r runs for a nil value, at a path that was found in the input or not.
+func runsForNil(r Rule, found bool) bool {
+	switch r.(type) {
+	case presenceRule:
+		return true
+	case nullCheck:
+		return found
+	default:
+		return false
+	}
+}
+
+// bailRule is the textual "bail" rule. It never fails; Schema.Field removes it and turns on Bail for the field.
+type bailRule struct{}
+
+func (bailRule) Validate(any) error { return nil }
+
 // Schema describes a set of fields and the rules that apply to each.
 //
 // A Schema is intended to be fully built up before Validate is called. Once built, Validate is safe to call from
 // multiple goroutines concurrently.
 type Schema struct {
-	fields []fieldRules
+	fields      []fieldRules
+	failFast    bool
+	concurrency int
+	strict      bool
+	allow       [][]string // path segments of the subtrees Strict lets through
+	loose       bool
 }
 
 type fieldRules struct {
-	path  string
-	rules []Rule
+	path       string
+	rules      []Rule
+	bail       bool
+	label      string
+	messages   map[string]string
+	sanitizers []Sanitizer
 }
 
 // New returns an empty Schema ready to be populated via Field.
@@ -91,9 +155,102 @@ func New() *Schema {
 
 // Field appends a list of rules for the given dot-notation path.
 //
+// Path segments may be numeric indexes into slices and arrays ("items.0.sku") or the wildcard "*", which matches every
+// element of a slice or array and every key of a map ("items.*.sku").
+//
+// Including the "bail" rule from a textual schema has the same effect as calling Bail after Field, and including the
+// "loose" rule wraps each of the field's rules with Loose.
+//
 // Field returns the receiver to support chaining.
 func (s *Schema) Field(path string, rules ...Rule) *Schema {
-	s.fields = append(s.fields, fieldRules{path: path, rules: rules})
+	f := fieldRules{path: path}
+	loose := false
+	for _, r := range rules {
+		switch r.(type) {
+		case bailRule:
+			f.bail = true
+		case looseRule:
+			loose = true
+		default:
+			f.rules = append(f.rules, r)
+		}
+	}
+	if loose {
+		for i, r := range f.rules {
+			f.rules[i] = Loose(r)
+		}
+	}
+	s.fields = append(s.fields, f)
+
+	return s
+}
+
+// Bail stops evaluating the rules of the most recently added field after its first failure, so later rules, such as an
+// expensive EmailMX after a failed Email, do not run. For a wildcard path, each matched element bails on its own.
+//
+// Bail has no effect on an empty schema. It returns the receiver to support chaining.
+//
+// Example:
+//
+//	schema := validation.New().
+//		Field("email", validation.Required, validation.Email, validation.EmailMX).Bail()
+func (s *Schema) Bail() *Schema {
+	if len(s.fields) > 0 {
+		s.fields[len(s.fields)-1].bail = true
+	}
+
+	return s
+}
+
+// Messages overrides the messages of the most recently added field, keyed by error code. A message may refer to the
+// error's Params and to the field's label by name in braces, as in Catalog templates:
+//
+//	Field("name", validation.MinLength(3)).Messages(map[string]string{
+//		"min_length": "{label} needs at least {length} letters",
+//	})
+//
+// Overridden messages are final: Result.Localize leaves them unchanged. Calling Messages again adds to the overrides.
+// Only errors reported at the field's own path are affected; errors of Each elements and Nested fields have deeper
+// paths and are customized by declaring those paths.
+//
+// Messages has no effect on an empty schema. It returns the receiver to support chaining.
+func (s *Schema) Messages(messages map[string]string) *Schema {
+	if len(s.fields) == 0 {
+		return s
+	}
+
+	f := &s.fields[len(s.fields)-1]
+	if f.messages == nil {
+		f.messages = make(map[string]string, len(messages))
+	}
+	for code, msg := range messages {
+		f.messages[code] = msg
+	}
+
+	return s
+}
+
+// Label sets the name the most recently added field goes by in messages, such as "Email address" for "email". The
+// errors of the field carry it in FieldError.Label, and those with a built-in code get a full English sentence as
+// their message, e.g. "Email address is required"; Result.Localize renders them in other locales. JSONSchema emits
+// the label as the property title.
+//
+// As with Messages, only errors reported at the field's own path are affected. Label has no effect on an empty
+// schema. It returns the receiver to support chaining.
+func (s *Schema) Label(label string) *Schema {
+	if len(s.fields) > 0 {
+		s.fields[len(s.fields)-1].label = label
+	}
+
+	return s
+}
+
+// FailFast makes Validate return as soon as the first FieldError is found, with that error as the only one in the
+// Result. Fields are checked in the order they were added.
+//
+// FailFast returns the receiver to support chaining.
+func (s *Schema) FailFast() *Schema {
+	s.failFast = true
 
 	return s
 }
@@ -101,42 +258,217 @@ func (s *Schema) Field(path string, rules ...Rule) *Schema {
 // Validate runs every rule against its corresponding field in the input and returns the collected errors.
 //
 // The input may be a map[string]any, a struct, a pointer to a struct, or any nested combination thereof. The returned
-// slice is empty (length zero) when validation succeeds. All rules for a field are executed; validation does not stop
-// at the first failure.
+// slice is empty (length zero) when validation succeeds. All rules for a field are executed and validation does not stop
+// at the first failure, unless the field uses Bail or the schema uses FailFast.
+//
+// Paths containing the wildcard segment "*" are expanded against the input first, and each match is validated and
+// reported at its concrete path, e.g. "items.*.sku" reports errors at "items.3.sku".
 func (s *Schema) Validate(input any) (*Result, error) {
-	var errs []FieldError
+	return s.ValidateContext(context.Background(), input)
+}
+
+// ValidateContext is like Validate, but passes ctx to every ContextRule, including those inside Nested sub-schemas, so
+// rules doing I/O respect its deadline and cancellation.
+//
+// If ctx ends before validation completes, ValidateContext stops and returns ctx.Err() as the error.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+//	defer cancel()
+//	res, err := schema.ValidateContext(ctx, input)
+func (s *Schema) ValidateContext(ctx context.Context, input any) (*Result, error) {
+	return s.validate(ctx, input, validateOptions{})
+}
+
+// ValidatePartial is like Validate, but only checks the fields present in the input, as for a PATCH request carrying
+// the changed fields alone. Rules of a field whose path is not found in the input, including Required and RequiredIf,
+// are skipped; a field that is present, even with a nil value, gets all of its rules. Nested sub-schemas are validated
+// partially as well.
+//
+// Example:
+//
+//	// {"email": "new@example.com"} passes even though name is Required.
+//	res, err := userSchema.ValidatePartial(patch)
+func (s *Schema) ValidatePartial(input any) (*Result, error) {
+	return s.ValidatePartialContext(context.Background(), input)
+}
+
+// ValidatePartialContext is ValidatePartial with a context, as ValidateContext is to Validate.
+func (s *Schema) ValidatePartialContext(ctx context.Context, input any) (*Result, error) {
+	return s.validate(ctx, input, validateOptions{partial: true})
+}
+
+// validateOptions select how validate treats its input.
+type validateOptions struct {
+	partial   bool // absent fields are not checked, see ValidatePartial
+	sanitized bool // the input is a copy that the sanitizers already ran on
+	loose     bool // numeric rules convert values, see Loose
+}
+
+func (s *Schema) validate(ctx context.Context, input any, opts validateOptions) (*Result, error) {
+	if !opts.sanitized && s.hasSanitizers() {
+		input = s.sanitizedInput(input, opts.partial)
+		opts.sanitized = true
+	}
+
 	inputBag := NewInputBag(input)
+	inputBag.ctx = ctx
+	inputBag.partial = opts.partial
+	if opts.sanitized {
+		inputBag.sanitized = s.sanitizedSchemas()
+	}
+	inputBag.loose = opts.loose || s.loose
 
-	for _, f := range s.fields {
-		value, _ := inputBag.Lookup(f.path)
-		for _, r := range f.rules {
-			if value == nil {
-				if _, ok := r.(presenceRule); !ok {
-					continue
-				}
-			}
+	var jobs []fieldJob
+	for i := range s.fields {
+		for _, path := range inputBag.expand(s.fields[i].path) {
+			jobs = append(jobs, fieldJob{path: path, field: &s.fields[i]})
+		}
+	}
+
+	res, err := s.validateJobs(ctx, inputBag, jobs)
+	if err != nil || !s.strict || (s.failFast && res.HasErrors()) {
+		return res, err
+	}
+
+	unknown := s.unknownFields(input)
+	if s.failFast && len(unknown) > 0 {
+		unknown = unknown[:1]
+	}
+	res.errors = append(res.errors, unknown...)
 
-			var err error
-			if ir, ok := r.(InputRule); ok {
-				err = ir.ValidateWithInput(value, inputBag)
-			} else {
-				err = r.Validate(value)
+	return res, nil
+}
+
+func (s *Schema) validateJobs(ctx context.Context, inputBag *InputBag, jobs []fieldJob) (*Result, error) {
+	if s.concurrency > 1 && len(jobs) > 1 {
+		return s.validateConcurrently(ctx, inputBag, jobs)
+	}
+
+	var errs []FieldError
+	for _, job := range jobs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		fieldErrs, err := s.validateJob(job, inputBag)
+		if err != nil {
+			return nil, err
+		}
+		errs = append(errs, fieldErrs...)
+
+		if s.failFast && len(errs) > 0 {
+			return &Result{errors: errs[:1]}, nil
+		}
+	}
+
+	return &Result{errors: errs}, nil
+}
+
+// fieldJob is a field's rules applied at one concrete path; a wildcard field expands into one job per match.
+type fieldJob struct {
+	path  string
+	field *fieldRules
+}
+
+func (s *Schema) validateJob(job fieldJob, inputBag *InputBag) ([]FieldError, error) {
+	errs, err := validateField(job.path, job.field.rules, inputBag, job.field.bail || s.failFast)
+	if err != nil {
+		return nil, err
+	}
+	job.field.customize(job.path, errs)
+
+	return errs, nil
+}
+
+// customize applies the field's label and message overrides to the errors reported at path.
+func (f *fieldRules) customize(path string, errs []FieldError) {
+	if f.label == "" && f.messages == nil {
+		return
+	}
+
+	for i := range errs {
+		fe := &errs[i]
+		if fe.Path != path {
+			continue
+		}
+
+		fe.Label = f.label
+		if tmpl, ok := f.messages[fe.Code]; ok {
+			fe.Message = fe.render(tmpl)
+			fe.custom = true
+		} else if msg, ok := defaultCatalog.Translate("en", *fe); ok && fe.Label != "" {
+			fe.Message = msg
+		}
+	}
+}
+
+// validateField runs rules against the value at a concrete path, stopping after the first failing rule when bail is
+// set. An error that aborts validation (see abortsValidation) is returned as the error. In partial validation an
+// absent path is not checked.
+func validateField(path string, rules []Rule, inputBag *InputBag, bail bool) ([]FieldError, error) {
+	var errs []FieldError
+
+	value, found := inputBag.Lookup(path)
+	if !found && inputBag.Partial() {
+		return nil, nil
+	}
+
+	for _, r := range rules {
+		if value == nil && !runsForNil(r, found) {
+			continue
+		}
+
+		if err := applyRule(r, value, inputBag); err != nil {
+			if abortsValidation(err) {
+				return nil, err
 			}
-			if err != nil {
-				var rse RuleSyntaxError
-				if errors.As(err, &rse) {
-					return nil, rse
-				}
-				code, params := codeAndParams(err)
-				errs = append(
-					errs,
-					FieldError{Path: f.path, Err: err, Message: err.Error(), Code: code, Params: params},
-				)
+			errs = append(errs, fieldErrors(path, err)...)
+			if bail {
+				break
 			}
 		}
 	}
 
-	return &Result{errors: errs}, nil
+	return errs, nil
+}
+
+// abortsValidation reports whether a rule error stops validation instead of being reported as a FieldError: a
+// RuleSyntaxError, or the error of a context that was canceled or ran out of time.
+func abortsValidation(err error) bool {
+	var rse RuleSyntaxError
+
+	return errors.As(err, &rse) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// fieldErrors converts a rule failure at path into FieldErrors. A nestedError expands into one FieldError per inner
+// failure, with path prefixed to the inner path.
+func fieldErrors(path string, err error) []FieldError {
+	var ne nestedError
+	if errors.As(err, &ne) {
+		errs := make([]FieldError, 0, len(ne.errors))
+		for _, fe := range ne.errors {
+			fe.Path = joinPath(path, fe.Path)
+			errs = append(errs, fe)
+		}
+		return errs
+	}
+
+	code, params := codeAndParams(err)
+	return []FieldError{{Path: path, Err: err, Message: err.Error(), Code: code, Params: params}}
+}
+
+// joinPath appends a relative path to a parent path. An empty relative path refers to the parent itself.
+func joinPath(parent, rel string) string {
+	switch {
+	case rel == "":
+		return parent
+	case parent == "":
+		return rel
+	default:
+		return parent + "." + rel
+	}
 }
 
 func codeAndParams(err error) (string, map[string]any) {
@@ -1,6 +1,10 @@
 package validation
 
 import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
 	"sync"
 	"testing"
 )
@@ -252,3 +256,397 @@ func TestSchemaField_Chaining(t *testing.T) {
 		t.Error("Field() should return receiver for chaining")
 	}
 }
+
+func TestSchemaValidate_Wildcard(t *testing.T) {
+	schema := New().
+		Field("items", Required, MinSize(1)).
+		Field("items.*.sku", Required, AlphaNum).
+		Field("items.0.qty", Required)
+
+	t.Run(
+		"valid items", func(t *testing.T) {
+			res, err := schema.Validate(
+				map[string]any{
+					"items": []any{
+						map[string]any{"sku": "A1", "qty": 2},
+						map[string]any{"sku": "B2"},
+					},
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.HasErrors() {
+				t.Errorf("unexpected errors: %v", res.Errors())
+			}
+		},
+	)
+
+	t.Run(
+		"errors reported at concrete paths", func(t *testing.T) {
+			res, err := schema.Validate(
+				map[string]any{
+					"items": []any{
+						map[string]any{"sku": "A1"},
+						map[string]any{"sku": "B2"},
+						map[string]any{"sku": "C-3"},
+						map[string]any{},
+					},
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			paths := pathCodes(res)
+			want := []string{"items.2.sku:alpha_num", "items.3.sku:required", "items.0.qty:required"}
+			if !slices.Equal(paths, want) {
+				t.Errorf("errors = %v, want %v", paths, want)
+			}
+		},
+	)
+
+	t.Run(
+		"struct slices", func(t *testing.T) {
+			type Item struct {
+				SKU string `json:"sku"`
+				Qty int    `json:"qty"`
+			}
+			type Order struct {
+				Items []Item `json:"items"`
+			}
+
+			res, err := schema.Validate(Order{Items: []Item{{SKU: "A1", Qty: 1}, {}}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			skuErrs := res.For("items.1.sku")
+			if len(skuErrs) == 0 || skuErrs[0].Code != "required" {
+				t.Errorf("expected required error for items.1.sku, got %v", res.Errors())
+			}
+		},
+	)
+
+	t.Run(
+		"absent collection matches nothing", func(t *testing.T) {
+			res, err := New().Field("items.*.sku", Required).Validate(map[string]any{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.HasErrors() {
+				t.Errorf("unexpected errors: %v", res.Errors())
+			}
+		},
+	)
+}
+
+func TestSchemaValidate_Bail(t *testing.T) {
+	calls := 0
+	expensive := RuleFunc(
+		func(any) error {
+			calls++
+			return nil
+		},
+	)
+
+	schema := New().
+		Field("email", Required, Email, MaxLength(5), expensive).Bail().
+		Field("name", MinLength(3), Regex(`^[a-z]+$`)).
+		Field("tags.*", MinLength(2), AlphaNum).Bail()
+
+	res, err := schema.Validate(
+		map[string]any{
+			"email": "not an email at all",
+			"name":  "X",
+			"tags":  []any{"a", "ok", "?"},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := pathCodes(res)
+	want := []string{"email:email", "name:min_length", "name:regex", "tags.0:min_length", "tags.2:min_length"}
+	if !slices.Equal(got, want) {
+		t.Errorf("errors = %v, want %v", got, want)
+	}
+	if calls != 0 {
+		t.Errorf("rule after the first failure ran %d times, want 0", calls)
+	}
+
+	if New().Bail() == nil {
+		t.Error("Bail on an empty schema should return the receiver")
+	}
+}
+
+func TestSchemaValidate_FailFast(t *testing.T) {
+	address := New().
+		Field("street", Required).
+		Field("city", Required)
+
+	schema := New().
+		Field("name", Required, MinLength(3)).
+		Field("address", Nested(address)).
+		Field("email", Required).
+		FailFast()
+
+	tests := []struct {
+		name  string
+		input map[string]any
+		want  []string
+	}{
+		{"valid", map[string]any{"name": "Ann", "address": map[string]any{"street": "a", "city": "b"}, "email": "x"}, nil},
+		{"first field", map[string]any{"name": "A", "address": map[string]any{}}, []string{"name:min_length"}},
+		{"nested errors", map[string]any{"name": "Ann", "address": map[string]any{}}, []string{"address.street:required"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				res, err := schema.Validate(tt.input)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got := pathCodes(res)
+				if !slices.Equal(got, tt.want) {
+					t.Errorf("errors = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestSchemaValidate_MessagesAndLabel(t *testing.T) {
+	schema := New().
+		Field("email", Required, Email).
+		Messages(map[string]string{"required": "We need your email"}).
+		Label("Email address").
+		Field("name", MinLength(3)).Messages(map[string]string{"min_length": "{label} needs {length} letters"}).
+		Field("age", Min[int](18)).Label("Age").
+		Field("tags", Each(MinLength(2))).Label("Tags").
+		Field("code", RuleFunc(func(any) error { return errors.New("bad code") })).Label("Code")
+
+	res, err := schema.Validate(map[string]any{"name": "X", "age": 7, "tags": []any{"a"}, "code": "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"email":  "We need your email",
+		"name":   "name needs 3 letters",
+		"age":    "Age must be at least 18",
+		"tags.0": "min length validation failed",
+		"code":   "bad code",
+	}
+	for path, msg := range want {
+		fes := res.For(path)
+		if len(fes) != 1 || fes[0].Message != msg {
+			t.Errorf("%s: errors = %v, want message %q", path, fes, msg)
+		}
+	}
+	if got := res.For("email")[0].Label; got != "Email address" {
+		t.Errorf("email label = %q", got)
+	}
+
+	res, err = schema.Validate(map[string]any{"email": "nope"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.For("email")[0].Message; got != "Email address must be a valid email address" {
+		t.Errorf("labeled message = %q", got)
+	}
+
+	if New().Messages(map[string]string{"required": "x"}).Label("x") == nil {
+		t.Error("Messages and Label on an empty schema should return the receiver")
+	}
+}
+
+func TestResult_TreeAndByField(t *testing.T) {
+	address := New().Field("city", Required).Field("zip", Required)
+	schema := New().
+		Field("name", Required, MinLength(3)).
+		Field("address", Nested(address)).
+		Field("tags", MaxSize(1), Each(Slug))
+
+	res, err := schema.Validate(
+		map[string]any{"name": "", "address": map[string]any{}, "tags": []any{"ok", "Not A Slug"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantTree := map[string]any{
+		"name": []string{"required validation failed", "min length validation failed"},
+		"address": map[string]any{
+			"city": []string{"required validation failed"},
+			"zip":  []string{"required validation failed"},
+		},
+		"tags": map[string]any{
+			"_errors": []string{"max size validation failed"},
+			"1":       []string{"slug validation failed"},
+		},
+	}
+	if got := res.Tree(); !reflect.DeepEqual(got, wantTree) {
+		t.Errorf("Tree() = %v\nwant %v", got, wantTree)
+	}
+
+	wantByField := map[string][]string{
+		"name":         {"required validation failed", "min length validation failed"},
+		"address.city": {"required validation failed"},
+		"address.zip":  {"required validation failed"},
+		"tags":         {"max size validation failed"},
+		"tags.1":       {"slug validation failed"},
+	}
+	if got := res.ByField(); !reflect.DeepEqual(got, wantByField) {
+		t.Errorf("ByField() = %v\nwant %v", got, wantByField)
+	}
+
+	// A path reported after errors below it joins them under _errors.
+	res = &Result{errors: []FieldError{{Path: "a.b", Message: "x"}, {Path: "a", Message: "y"}}}
+	want := map[string]any{"a": map[string]any{"b": []string{"x"}, "_errors": []string{"y"}}}
+	if got := res.Tree(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Tree() = %v, want %v", got, want)
+	}
+}
+
+func TestSchemaValidatePartial(t *testing.T) {
+	address := New().
+		Field("street", Required).
+		Field("city", Required, MinLength(2))
+
+	schema := New().
+		Field("name", Required, MinLength(2)).
+		Field("email", Required, Email).
+		Field("vat", RequiredIf(`country == "DE"`)).
+		Field("address", Nested(address)).
+		Field("tags.*", Required, Slug)
+
+	tests := []struct {
+		name  string
+		input map[string]any
+		want  []string
+	}{
+		{"empty patch", map[string]any{}, nil},
+		{"valid field", map[string]any{"email": "a@example.com"}, nil},
+		{"invalid field", map[string]any{"email": "nope"}, []string{"email:email"}},
+		{"explicit null", map[string]any{"name": nil}, []string{"name:required"}},
+		{"conditional skipped", map[string]any{"country": "DE"}, nil},
+		{"nested partial", map[string]any{"address": map[string]any{"city": "X"}}, []string{"address.city:min_length"}},
+		{"elements", map[string]any{"tags": []any{"ok", "Not A Slug"}}, []string{"tags.1:slug"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				res, err := schema.ValidatePartial(tt.input)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got := pathCodes(res)
+				if !slices.Equal(got, tt.want) {
+					t.Errorf("errors = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+
+	res, err := schema.Validate(map[string]any{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Errors()) != 2 {
+		t.Errorf("full validation after partial: errors = %v, want name and email required", res.Errors())
+	}
+}
+
+type ctxKey struct{}
+
+func TestSchemaValidateContext(t *testing.T) {
+	var seen []any
+	recordCtx := ContextRuleFunc(
+		func(ctx context.Context, _ any, _ *InputBag) error {
+			seen = append(seen, ctx.Value(ctxKey{}))
+			return nil
+		},
+	)
+
+	inner := New().Field("code", recordCtx)
+	schema := New().
+		Field("name", recordCtx).
+		Field("tags", Each(recordCtx)).
+		Field("child", Nested(inner)).
+		Field("alt", Any(recordCtx))
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+	res, err := schema.ValidateContext(
+		ctx, map[string]any{
+			"name":  "x",
+			"tags":  []any{"a"},
+			"child": map[string]any{"code": "c"},
+			"alt":   "y",
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HasErrors() {
+		t.Errorf("unexpected errors: %v", res.Errors())
+	}
+	if want := []any{"request", "request", "request", "request"}; !slices.Equal(seen, want) {
+		t.Errorf("contexts seen = %v, want %v", seen, want)
+	}
+
+	seen = nil
+	if _, err := schema.Validate(map[string]any{"name": "x"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []any{nil}; !slices.Equal(seen, want) {
+		t.Errorf("Validate contexts seen = %v, want %v", seen, want)
+	}
+}
+
+func TestSchemaValidateContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	cancelling := ContextRuleFunc(
+		func(ctx context.Context, _ any, _ *InputBag) error {
+			calls++
+			cancel()
+			return ctx.Err()
+		},
+	)
+
+	schema := New().
+		Field("a", cancelling).
+		Field("b", cancelling)
+
+	res, err := schema.ValidateContext(ctx, map[string]any{"a": 1, "b": 2})
+	if !errors.Is(err, context.Canceled) || res != nil {
+		t.Errorf("ValidateContext = %v, %v; want nil, context.Canceled", res, err)
+	}
+	if calls != 1 {
+		t.Errorf("rule calls = %d, want 1", calls)
+	}
+
+	err = EmailMX.(ContextRule).ValidateContext(ctx, "user@example.com", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("EmailMX with a canceled context error = %v, want context.Canceled", err)
+	}
+
+	nestedCtx, nestedCancel := context.WithCancel(context.Background())
+	cancelling = ContextRuleFunc(
+		func(ctx context.Context, _ any, _ *InputBag) error {
+			nestedCancel()
+			return ctx.Err()
+		},
+	)
+	_, err = New().Field("list", Each(Nested(New().Field("code", cancelling)))).ValidateContext(
+		nestedCtx, map[string]any{"list": []any{map[string]any{"code": "x"}}},
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled inside Each(Nested) error = %v, want context.Canceled", err)
+	}
+}